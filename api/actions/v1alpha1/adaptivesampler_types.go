@@ -0,0 +1,82 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/odigos-io/odigos/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdaptiveSamplerSpec defines the desired state of AdaptiveSampler action
+type AdaptiveSamplerSpec struct {
+	ActionName string                       `json:"actionName,omitempty"`
+	Notes      string                       `json:"notes,omitempty"`
+	Disabled   bool                         `json:"disabled,omitempty"`
+	Signals    []common.ObservabilitySignal `json:"signals"`
+
+	// Specifies the number of traces per second to keep for each service.
+	// The sampling ratio of each service is continuously adjusted from its observed throughput to reach this target.
+	// +kubebuilder:validation:Required
+	TargetTracesPerSecond float64 `json:"target_traces_per_second"`
+	// Specifies the services the target applies to, every service gets its own target when empty.
+	// +kubebuilder:validation:Optional
+	ServiceNames []string `json:"service_names,omitempty"`
+	// Specifies the lowest sampling ratio the sampler can adjust to, defaults to 0.1.
+	// +kubebuilder:validation:Optional
+	MinSamplingRatio float64 `json:"min_sampling_ratio,omitempty"`
+	// Specifies how often the sampling ratio is adjusted (e.g. "10s"), defaults to 10s.
+	// +kubebuilder:validation:Optional
+	AdjustmentInterval string `json:"adjustment_interval,omitempty"`
+}
+
+// AdaptiveSamplerStatus defines the observed state of AdaptiveSampler action
+type AdaptiveSamplerStatus struct {
+	// Represents the observations of a AdaptiveSampler's current state.
+	// Known .status.conditions.type are: "Available", "Progressing"
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=adaptivesamplers,scope=Namespaced,shortName=as
+
+// AdaptiveSampler is the Schema for the AdaptiveSampler odigos action API
+type AdaptiveSampler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AdaptiveSamplerSpec   `json:"spec,omitempty"`
+	Status AdaptiveSamplerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AdaptiveSamplerList contains a list of AdaptiveSampler
+type AdaptiveSamplerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AdaptiveSampler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AdaptiveSampler{}, &AdaptiveSamplerList{})
+}
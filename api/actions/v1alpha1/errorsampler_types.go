@@ -29,8 +29,26 @@ type ErrorSamplerSpec struct {
 	Signals    []common.ObservabilitySignal `json:"signals"`
 
 	// Specifies the ratio of non-error traces to be sampled.
+	// A ratio of 0 drops all non-error traces, earlier versions ignored the action in that case.
 	// +kubebuilder:validation:Required
 	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
+
+	// Specifies the service whose spans are considered, all services are considered when empty.
+	// +kubebuilder:validation:Optional
+	ServiceName string `json:"service_name,omitempty"`
+	// Specifies the http status codes considered as errors, e.g. "5xx", "500-504" or "429".
+	// When set, spans with an http status code are errors only if it is listed, regardless of the span status.
+	// +kubebuilder:validation:Optional
+	HttpStatusCodes []string `json:"http_status_codes,omitempty"`
+	// Specifies the http status codes never considered as errors, e.g. "404".
+	// +kubebuilder:validation:Optional
+	ExcludedHttpStatusCodes []string `json:"excluded_http_status_codes,omitempty"`
+	// Specifies whether spans with an exception event are considered as errors.
+	// +kubebuilder:validation:Optional
+	ExceptionEvents bool `json:"exception_events,omitempty"`
+	// Specifies the exception.type values of exception events considered as errors.
+	// +kubebuilder:validation:Optional
+	ExceptionTypes []string `json:"exception_types,omitempty"`
 }
 
 // ErrorSamplerStatus defines the observed state of ErrorSampler action
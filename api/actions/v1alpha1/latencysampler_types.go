@@ -34,9 +34,18 @@ type LatencySamplerSpec struct {
 }
 
 type HttpRouteFilter struct {
-	// Specifies the http.route to be sampled
+	// Specifies the http.route to be sampled, or the pattern to match against the match key attribute
 	// +kubebuilder:validation:Required
 	HttpRoute string `json:"http_route"`
+	// Specifies the span attribute the route is matched against, defaults to http.route
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=http.route;rpc.method;messaging.destination.name
+	MatchKey string `json:"match_key,omitempty"`
+	// Specifies how the route is matched: "prefix" (default), "glob" or "regex".
+	// In a glob, "*" matches within a single path segment, and a "**" segment matches any number of segments.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=prefix;glob;regex
+	MatchType string `json:"match_type,omitempty"`
 	// Specifies the service to be sampled
 	// +kubebuilder:validation:Required
 	ServiceName string `json:"service_name"`
@@ -44,6 +53,7 @@ type HttpRouteFilter struct {
 	// +kubebuilder:validation:Required
 	MinimumLatencyThreshold int `json:"minimum_latency_threshold"`
 	// Specifies the fallback sampling ratio to be applied in case service and endpoint filter match but the latency threshold is not met.
+	// A ratio of 0 drops these traces, earlier versions ignored the filter in that case.
 	// +kubebuilder:validation:Required
 	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
 }
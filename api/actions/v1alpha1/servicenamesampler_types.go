@@ -0,0 +1,81 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/odigos-io/odigos/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceNameSamplerSpec defines the desired state of ServiceNameSampler action
+type ServiceNameSamplerSpec struct {
+	ActionName string                       `json:"actionName,omitempty"`
+	Notes      string                       `json:"notes,omitempty"`
+	Disabled   bool                         `json:"disabled,omitempty"`
+	Signals    []common.ObservabilitySignal `json:"signals"`
+
+	// Specifies the list of services and the ratio of their traces to be sampled
+	// +kubebuilder:validation:Required
+	ServicesNameFilters []ServiceNameFilter `json:"services_name_filters"`
+}
+
+type ServiceNameFilter struct {
+	// Specifies the service the filter applies to
+	// +kubebuilder:validation:Required
+	ServiceName string `json:"service_name"`
+	// Specifies the ratio of traces containing the service to be sampled, 100 keeps all of them.
+	// +kubebuilder:validation:Required
+	SamplingRatio float64 `json:"sampling_ratio"`
+}
+
+// ServiceNameSamplerStatus defines the observed state of ServiceNameSampler action
+type ServiceNameSamplerStatus struct {
+	// Represents the observations of a ServiceNameSampler's current state.
+	// Known .status.conditions.type are: "Available", "Progressing"
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=servicenamesamplers,scope=Namespaced,shortName=sns
+
+// ServiceNameSampler is the Schema for the ServiceNameSampler odigos action API
+type ServiceNameSampler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceNameSamplerSpec   `json:"spec,omitempty"`
+	Status ServiceNameSamplerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ServiceNameSamplerList contains a list of ServiceNameSampler
+type ServiceNameSamplerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceNameSampler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceNameSampler{}, &ServiceNameSamplerList{})
+}
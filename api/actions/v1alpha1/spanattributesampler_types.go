@@ -0,0 +1,88 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/odigos-io/odigos/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpanAttributeSamplerSpec defines the desired state of SpanAttributeSampler action
+type SpanAttributeSamplerSpec struct {
+	ActionName string                       `json:"actionName,omitempty"`
+	Notes      string                       `json:"notes,omitempty"`
+	Disabled   bool                         `json:"disabled,omitempty"`
+	Signals    []common.ObservabilitySignal `json:"signals"`
+
+	// Specifies the list of span attribute filters to be applied for sampling
+	// +kubebuilder:validation:Required
+	AttributeFilters []SpanAttributeFilter `json:"attribute_filters"`
+}
+
+type SpanAttributeFilter struct {
+	// Specifies the span attribute key to filter on, e.g. enduser.id or tenant.tier
+	// +kubebuilder:validation:Required
+	AttributeKey string `json:"attribute_key"`
+	// Specifies how the attribute is matched: "exists", "equals" the value, or "regex" matching the value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=exists;equals;regex
+	Condition string `json:"condition"`
+	// Specifies the value to compare the attribute to, required for the "equals" and "regex" conditions
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+	// Specifies the ratio of traces to be sampled in case no span of the trace matches the filter.
+	// +kubebuilder:validation:Required
+	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
+}
+
+// SpanAttributeSamplerStatus defines the observed state of SpanAttributeSampler action
+type SpanAttributeSamplerStatus struct {
+	// Represents the observations of a SpanAttributeSampler's current state.
+	// Known .status.conditions.type are: "Available", "Progressing"
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:path=spanattributesamplers,scope=Namespaced,shortName=sas
+
+// SpanAttributeSampler is the Schema for the SpanAttributeSampler odigos action API
+type SpanAttributeSampler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpanAttributeSamplerSpec   `json:"spec,omitempty"`
+	Status SpanAttributeSamplerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SpanAttributeSamplerList contains a list of SpanAttributeSampler
+type SpanAttributeSamplerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpanAttributeSampler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SpanAttributeSampler{}, &SpanAttributeSamplerList{})
+}
@@ -26,6 +26,112 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSampler) DeepCopyInto(out *AdaptiveSampler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSampler.
+func (in *AdaptiveSampler) DeepCopy() *AdaptiveSampler {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSampler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdaptiveSampler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSamplerList) DeepCopyInto(out *AdaptiveSamplerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AdaptiveSampler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSamplerList.
+func (in *AdaptiveSamplerList) DeepCopy() *AdaptiveSamplerList {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSamplerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdaptiveSamplerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSamplerSpec) DeepCopyInto(out *AdaptiveSamplerSpec) {
+	*out = *in
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]common.ObservabilitySignal, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceNames != nil {
+		in, out := &in.ServiceNames, &out.ServiceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSamplerSpec.
+func (in *AdaptiveSamplerSpec) DeepCopy() *AdaptiveSamplerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSamplerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveSamplerStatus) DeepCopyInto(out *AdaptiveSamplerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveSamplerStatus.
+func (in *AdaptiveSamplerStatus) DeepCopy() *AdaptiveSamplerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveSamplerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddClusterInfo) DeepCopyInto(out *AddClusterInfo) {
 	*out = *in
@@ -307,6 +413,21 @@ func (in *ErrorSamplerSpec) DeepCopyInto(out *ErrorSamplerSpec) {
 		*out = make([]common.ObservabilitySignal, len(*in))
 		copy(*out, *in)
 	}
+	if in.HttpStatusCodes != nil {
+		in, out := &in.HttpStatusCodes, &out.HttpStatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedHttpStatusCodes != nil {
+		in, out := &in.ExcludedHttpStatusCodes, &out.ExcludedHttpStatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExceptionTypes != nil {
+		in, out := &in.ExceptionTypes, &out.ExceptionTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorSamplerSpec.
@@ -690,3 +811,245 @@ func (in *RenameAttributeStatus) DeepCopy() *RenameAttributeStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNameFilter) DeepCopyInto(out *ServiceNameFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNameFilter.
+func (in *ServiceNameFilter) DeepCopy() *ServiceNameFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNameFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNameSampler) DeepCopyInto(out *ServiceNameSampler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNameSampler.
+func (in *ServiceNameSampler) DeepCopy() *ServiceNameSampler {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNameSampler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceNameSampler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNameSamplerList) DeepCopyInto(out *ServiceNameSamplerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceNameSampler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNameSamplerList.
+func (in *ServiceNameSamplerList) DeepCopy() *ServiceNameSamplerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNameSamplerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceNameSamplerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNameSamplerSpec) DeepCopyInto(out *ServiceNameSamplerSpec) {
+	*out = *in
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]common.ObservabilitySignal, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServicesNameFilters != nil {
+		in, out := &in.ServicesNameFilters, &out.ServicesNameFilters
+		*out = make([]ServiceNameFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNameSamplerSpec.
+func (in *ServiceNameSamplerSpec) DeepCopy() *ServiceNameSamplerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNameSamplerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceNameSamplerStatus) DeepCopyInto(out *ServiceNameSamplerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceNameSamplerStatus.
+func (in *ServiceNameSamplerStatus) DeepCopy() *ServiceNameSamplerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceNameSamplerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttributeFilter) DeepCopyInto(out *SpanAttributeFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttributeFilter.
+func (in *SpanAttributeFilter) DeepCopy() *SpanAttributeFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttributeFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttributeSampler) DeepCopyInto(out *SpanAttributeSampler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttributeSampler.
+func (in *SpanAttributeSampler) DeepCopy() *SpanAttributeSampler {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttributeSampler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpanAttributeSampler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttributeSamplerList) DeepCopyInto(out *SpanAttributeSamplerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpanAttributeSampler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttributeSamplerList.
+func (in *SpanAttributeSamplerList) DeepCopy() *SpanAttributeSamplerList {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttributeSamplerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpanAttributeSamplerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttributeSamplerSpec) DeepCopyInto(out *SpanAttributeSamplerSpec) {
+	*out = *in
+	if in.Signals != nil {
+		in, out := &in.Signals, &out.Signals
+		*out = make([]common.ObservabilitySignal, len(*in))
+		copy(*out, *in)
+	}
+	if in.AttributeFilters != nil {
+		in, out := &in.AttributeFilters, &out.AttributeFilters
+		*out = make([]SpanAttributeFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttributeSamplerSpec.
+func (in *SpanAttributeSamplerSpec) DeepCopy() *SpanAttributeSamplerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttributeSamplerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpanAttributeSamplerStatus) DeepCopyInto(out *SpanAttributeSamplerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpanAttributeSamplerStatus.
+func (in *SpanAttributeSamplerStatus) DeepCopy() *SpanAttributeSamplerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SpanAttributeSamplerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -24,11 +24,16 @@ import (
 // ErrorSamplerSpecApplyConfiguration represents an declarative configuration of the ErrorSamplerSpec type for use
 // with apply.
 type ErrorSamplerSpecApplyConfiguration struct {
-	ActionName            *string                      `json:"actionName,omitempty"`
-	Notes                 *string                      `json:"notes,omitempty"`
-	Disabled              *bool                        `json:"disabled,omitempty"`
-	Signals               []common.ObservabilitySignal `json:"signals,omitempty"`
-	FallbackSamplingRatio *float64                     `json:"fallback_sampling_ratio,omitempty"`
+	ActionName              *string                      `json:"actionName,omitempty"`
+	Notes                   *string                      `json:"notes,omitempty"`
+	Disabled                *bool                        `json:"disabled,omitempty"`
+	Signals                 []common.ObservabilitySignal `json:"signals,omitempty"`
+	FallbackSamplingRatio   *float64                     `json:"fallback_sampling_ratio,omitempty"`
+	ServiceName             *string                      `json:"service_name,omitempty"`
+	HttpStatusCodes         []string                     `json:"http_status_codes,omitempty"`
+	ExcludedHttpStatusCodes []string                     `json:"excluded_http_status_codes,omitempty"`
+	ExceptionEvents         *bool                        `json:"exception_events,omitempty"`
+	ExceptionTypes          []string                     `json:"exception_types,omitempty"`
 }
 
 // ErrorSamplerSpecApplyConfiguration constructs an declarative configuration of the ErrorSamplerSpec type for use with
@@ -78,3 +83,49 @@ func (b *ErrorSamplerSpecApplyConfiguration) WithFallbackSamplingRatio(value flo
 	b.FallbackSamplingRatio = &value
 	return b
 }
+
+// WithServiceName sets the ServiceName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServiceName field is set to the value of the last call.
+func (b *ErrorSamplerSpecApplyConfiguration) WithServiceName(value string) *ErrorSamplerSpecApplyConfiguration {
+	b.ServiceName = &value
+	return b
+}
+
+// WithHttpStatusCodes adds the given value to the HttpStatusCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the HttpStatusCodes field.
+func (b *ErrorSamplerSpecApplyConfiguration) WithHttpStatusCodes(values ...string) *ErrorSamplerSpecApplyConfiguration {
+	for i := range values {
+		b.HttpStatusCodes = append(b.HttpStatusCodes, values[i])
+	}
+	return b
+}
+
+// WithExcludedHttpStatusCodes adds the given value to the ExcludedHttpStatusCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludedHttpStatusCodes field.
+func (b *ErrorSamplerSpecApplyConfiguration) WithExcludedHttpStatusCodes(values ...string) *ErrorSamplerSpecApplyConfiguration {
+	for i := range values {
+		b.ExcludedHttpStatusCodes = append(b.ExcludedHttpStatusCodes, values[i])
+	}
+	return b
+}
+
+// WithExceptionEvents sets the ExceptionEvents field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExceptionEvents field is set to the value of the last call.
+func (b *ErrorSamplerSpecApplyConfiguration) WithExceptionEvents(value bool) *ErrorSamplerSpecApplyConfiguration {
+	b.ExceptionEvents = &value
+	return b
+}
+
+// WithExceptionTypes adds the given value to the ExceptionTypes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExceptionTypes field.
+func (b *ErrorSamplerSpecApplyConfiguration) WithExceptionTypes(values ...string) *ErrorSamplerSpecApplyConfiguration {
+	for i := range values {
+		b.ExceptionTypes = append(b.ExceptionTypes, values[i])
+	}
+	return b
+}
@@ -21,6 +21,8 @@ package v1alpha1
 // with apply.
 type HttpRouteFilterApplyConfiguration struct {
 	HttpRoute               *string  `json:"http_route,omitempty"`
+	MatchKey                *string  `json:"match_key,omitempty"`
+	MatchType               *string  `json:"match_type,omitempty"`
 	ServiceName             *string  `json:"service_name,omitempty"`
 	MinimumLatencyThreshold *int     `json:"minimum_latency_threshold,omitempty"`
 	FallbackSamplingRatio   *float64 `json:"fallback_sampling_ratio,omitempty"`
@@ -40,6 +42,22 @@ func (b *HttpRouteFilterApplyConfiguration) WithHttpRoute(value string) *HttpRou
 	return b
 }
 
+// WithMatchKey sets the MatchKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MatchKey field is set to the value of the last call.
+func (b *HttpRouteFilterApplyConfiguration) WithMatchKey(value string) *HttpRouteFilterApplyConfiguration {
+	b.MatchKey = &value
+	return b
+}
+
+// WithMatchType sets the MatchType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MatchType field is set to the value of the last call.
+func (b *HttpRouteFilterApplyConfiguration) WithMatchType(value string) *HttpRouteFilterApplyConfiguration {
+	b.MatchType = &value
+	return b
+}
+
 // WithServiceName sets the ServiceName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceName field is set to the value of the last call.
@@ -27,12 +27,15 @@ import (
 
 type ActionsV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AdaptiveSamplersGetter
 	AddClusterInfosGetter
 	DeleteAttributesGetter
 	ErrorSamplersGetter
 	LatencySamplersGetter
 	ProbabilisticSamplersGetter
 	RenameAttributesGetter
+	ServiceNameSamplersGetter
+	SpanAttributeSamplersGetter
 }
 
 // ActionsV1alpha1Client is used to interact with features provided by the actions group.
@@ -40,6 +43,10 @@ type ActionsV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ActionsV1alpha1Client) AdaptiveSamplers(namespace string) AdaptiveSamplerInterface {
+	return newAdaptiveSamplers(c, namespace)
+}
+
 func (c *ActionsV1alpha1Client) AddClusterInfos(namespace string) AddClusterInfoInterface {
 	return newAddClusterInfos(c, namespace)
 }
@@ -64,6 +71,14 @@ func (c *ActionsV1alpha1Client) RenameAttributes(namespace string) RenameAttribu
 	return newRenameAttributes(c, namespace)
 }
 
+func (c *ActionsV1alpha1Client) ServiceNameSamplers(namespace string) ServiceNameSamplerInterface {
+	return newServiceNameSamplers(c, namespace)
+}
+
+func (c *ActionsV1alpha1Client) SpanAttributeSamplers(namespace string) SpanAttributeSamplerInterface {
+	return newSpanAttributeSamplers(c, namespace)
+}
+
 // NewForConfig creates a new ActionsV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -27,6 +27,10 @@ type FakeActionsV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeActionsV1alpha1) AdaptiveSamplers(namespace string) v1alpha1.AdaptiveSamplerInterface {
+	return &FakeAdaptiveSamplers{c, namespace}
+}
+
 func (c *FakeActionsV1alpha1) AddClusterInfos(namespace string) v1alpha1.AddClusterInfoInterface {
 	return &FakeAddClusterInfos{c, namespace}
 }
@@ -51,6 +55,14 @@ func (c *FakeActionsV1alpha1) RenameAttributes(namespace string) v1alpha1.Rename
 	return &FakeRenameAttributes{c, namespace}
 }
 
+func (c *FakeActionsV1alpha1) ServiceNameSamplers(namespace string) v1alpha1.ServiceNameSamplerInterface {
+	return &FakeServiceNameSamplers{c, namespace}
+}
+
+func (c *FakeActionsV1alpha1) SpanAttributeSamplers(namespace string) v1alpha1.SpanAttributeSamplerInterface {
+	return &FakeSpanAttributeSamplers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeActionsV1alpha1) RESTClient() rest.Interface {
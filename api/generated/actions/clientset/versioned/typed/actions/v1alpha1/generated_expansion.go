@@ -17,6 +17,8 @@ limitations under the License.
 
 package v1alpha1
 
+type AdaptiveSamplerExpansion interface{}
+
 type AddClusterInfoExpansion interface{}
 
 type DeleteAttributeExpansion interface{}
@@ -28,3 +30,7 @@ type LatencySamplerExpansion interface{}
 type ProbabilisticSamplerExpansion interface{}
 
 type RenameAttributeExpansion interface{}
+
+type ServiceNameSamplerExpansion interface{}
+
+type SpanAttributeSamplerExpansion interface{}
@@ -23,6 +23,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AdaptiveSamplers returns a AdaptiveSamplerInformer.
+	AdaptiveSamplers() AdaptiveSamplerInformer
 	// AddClusterInfos returns a AddClusterInfoInformer.
 	AddClusterInfos() AddClusterInfoInformer
 	// DeleteAttributes returns a DeleteAttributeInformer.
@@ -35,6 +37,10 @@ type Interface interface {
 	ProbabilisticSamplers() ProbabilisticSamplerInformer
 	// RenameAttributes returns a RenameAttributeInformer.
 	RenameAttributes() RenameAttributeInformer
+	// ServiceNameSamplers returns a ServiceNameSamplerInformer.
+	ServiceNameSamplers() ServiceNameSamplerInformer
+	// SpanAttributeSamplers returns a SpanAttributeSamplerInformer.
+	SpanAttributeSamplers() SpanAttributeSamplerInformer
 }
 
 type version struct {
@@ -48,6 +54,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AdaptiveSamplers returns a AdaptiveSamplerInformer.
+func (v *version) AdaptiveSamplers() AdaptiveSamplerInformer {
+	return &adaptiveSamplerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // AddClusterInfos returns a AddClusterInfoInformer.
 func (v *version) AddClusterInfos() AddClusterInfoInformer {
 	return &addClusterInfoInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -77,3 +88,13 @@ func (v *version) ProbabilisticSamplers() ProbabilisticSamplerInformer {
 func (v *version) RenameAttributes() RenameAttributeInformer {
 	return &renameAttributeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ServiceNameSamplers returns a ServiceNameSamplerInformer.
+func (v *version) ServiceNameSamplers() ServiceNameSamplerInformer {
+	return &serviceNameSamplerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SpanAttributeSamplers returns a SpanAttributeSamplerInformer.
+func (v *version) SpanAttributeSamplers() SpanAttributeSamplerInformer {
+	return &spanAttributeSamplerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
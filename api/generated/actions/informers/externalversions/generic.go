@@ -52,6 +52,8 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=actions, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("adaptivesamplers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().AdaptiveSamplers().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("addclusterinfos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().AddClusterInfos().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("deleteattributes"):
@@ -64,6 +66,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().ProbabilisticSamplers().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("renameattributes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().RenameAttributes().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("servicenamesamplers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().ServiceNameSamplers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("spanattributesamplers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Actions().V1alpha1().SpanAttributeSamplers().Informer()}, nil
 
 	}
 
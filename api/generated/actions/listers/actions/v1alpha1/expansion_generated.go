@@ -17,6 +17,14 @@ limitations under the License.
 
 package v1alpha1
 
+// AdaptiveSamplerListerExpansion allows custom methods to be added to
+// AdaptiveSamplerLister.
+type AdaptiveSamplerListerExpansion interface{}
+
+// AdaptiveSamplerNamespaceListerExpansion allows custom methods to be added to
+// AdaptiveSamplerNamespaceLister.
+type AdaptiveSamplerNamespaceListerExpansion interface{}
+
 // AddClusterInfoListerExpansion allows custom methods to be added to
 // AddClusterInfoLister.
 type AddClusterInfoListerExpansion interface{}
@@ -64,3 +72,19 @@ type RenameAttributeListerExpansion interface{}
 // RenameAttributeNamespaceListerExpansion allows custom methods to be added to
 // RenameAttributeNamespaceLister.
 type RenameAttributeNamespaceListerExpansion interface{}
+
+// ServiceNameSamplerListerExpansion allows custom methods to be added to
+// ServiceNameSamplerLister.
+type ServiceNameSamplerListerExpansion interface{}
+
+// ServiceNameSamplerNamespaceListerExpansion allows custom methods to be added to
+// ServiceNameSamplerNamespaceLister.
+type ServiceNameSamplerNamespaceListerExpansion interface{}
+
+// SpanAttributeSamplerListerExpansion allows custom methods to be added to
+// SpanAttributeSamplerLister.
+type SpanAttributeSamplerListerExpansion interface{}
+
+// SpanAttributeSamplerNamespaceListerExpansion allows custom methods to be added to
+// SpanAttributeSamplerNamespaceLister.
+type SpanAttributeSamplerNamespaceListerExpansion interface{}
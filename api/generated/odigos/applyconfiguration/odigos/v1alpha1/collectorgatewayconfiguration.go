@@ -20,10 +20,17 @@ package v1alpha1
 // CollectorGatewayConfigurationApplyConfiguration represents an declarative configuration of the CollectorGatewayConfiguration type for use
 // with apply.
 type CollectorGatewayConfigurationApplyConfiguration struct {
-	RequestMemoryMiB           *int `json:"requestMemoryMiB,omitempty"`
-	MemoryLimiterLimitMiB      *int `json:"memoryLimiterLimitMiB,omitempty"`
-	MemoryLimiterSpikeLimitMiB *int `json:"memoryLimiterSpikeLimitMiB,omitempty"`
-	GoMemLimitMib              *int `json:"goMemLimitMiB,omitempty"`
+	RequestMemoryMiB                    *int                                      `json:"requestMemoryMiB,omitempty"`
+	MemoryLimiterLimitMiB               *int                                      `json:"memoryLimiterLimitMiB,omitempty"`
+	MemoryLimiterSpikeLimitMiB          *int                                      `json:"memoryLimiterSpikeLimitMiB,omitempty"`
+	GoMemLimitMib                       *int                                      `json:"goMemLimitMiB,omitempty"`
+	MinReplicas                         *int                                      `json:"minReplicas,omitempty"`
+	MaxReplicas                         *int                                      `json:"maxReplicas,omitempty"`
+	RequestCPUm                         *int                                      `json:"requestCPUm,omitempty"`
+	TargetCPUUtilizationPercent         *int                                      `json:"targetCPUUtilizationPercent,omitempty"`
+	ScaleUpStabilizationWindowSeconds   *int32                                    `json:"scaleUpStabilizationWindowSeconds,omitempty"`
+	ScaleDownStabilizationWindowSeconds *int32                                    `json:"scaleDownStabilizationWindowSeconds,omitempty"`
+	PersistentQueueStorage              *PersistentQueueStorageApplyConfiguration `json:"persistentQueueStorage,omitempty"`
 }
 
 // CollectorGatewayConfigurationApplyConfiguration constructs an declarative configuration of the CollectorGatewayConfiguration type for use with
@@ -63,3 +70,59 @@ func (b *CollectorGatewayConfigurationApplyConfiguration) WithGoMemLimitMib(valu
 	b.GoMemLimitMib = &value
 	return b
 }
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithMinReplicas(value int) *CollectorGatewayConfigurationApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithMaxReplicas(value int) *CollectorGatewayConfigurationApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
+
+// WithRequestCPUm sets the RequestCPUm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestCPUm field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithRequestCPUm(value int) *CollectorGatewayConfigurationApplyConfiguration {
+	b.RequestCPUm = &value
+	return b
+}
+
+// WithTargetCPUUtilizationPercent sets the TargetCPUUtilizationPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetCPUUtilizationPercent field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithTargetCPUUtilizationPercent(value int) *CollectorGatewayConfigurationApplyConfiguration {
+	b.TargetCPUUtilizationPercent = &value
+	return b
+}
+
+// WithScaleUpStabilizationWindowSeconds sets the ScaleUpStabilizationWindowSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleUpStabilizationWindowSeconds field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithScaleUpStabilizationWindowSeconds(value int32) *CollectorGatewayConfigurationApplyConfiguration {
+	b.ScaleUpStabilizationWindowSeconds = &value
+	return b
+}
+
+// WithScaleDownStabilizationWindowSeconds sets the ScaleDownStabilizationWindowSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScaleDownStabilizationWindowSeconds field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithScaleDownStabilizationWindowSeconds(value int32) *CollectorGatewayConfigurationApplyConfiguration {
+	b.ScaleDownStabilizationWindowSeconds = &value
+	return b
+}
+
+// WithPersistentQueueStorage sets the PersistentQueueStorage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentQueueStorage field is set to the value of the last call.
+func (b *CollectorGatewayConfigurationApplyConfiguration) WithPersistentQueueStorage(value *PersistentQueueStorageApplyConfiguration) *CollectorGatewayConfigurationApplyConfiguration {
+	b.PersistentQueueStorage = value
+	return b
+}
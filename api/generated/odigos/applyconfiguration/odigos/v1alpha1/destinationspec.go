@@ -25,11 +25,12 @@ import (
 // DestinationSpecApplyConfiguration represents an declarative configuration of the DestinationSpec type for use
 // with apply.
 type DestinationSpecApplyConfiguration struct {
-	Type            *common.DestinationType      `json:"type,omitempty"`
-	DestinationName *string                      `json:"destinationName,omitempty"`
-	Data            map[string]string            `json:"data,omitempty"`
-	SecretRef       *v1.LocalObjectReference     `json:"secretRef,omitempty"`
-	Signals         []common.ObservabilitySignal `json:"signals,omitempty"`
+	Type            *common.DestinationType            `json:"type,omitempty"`
+	DestinationName *string                            `json:"destinationName,omitempty"`
+	Data            map[string]string                  `json:"data,omitempty"`
+	SecretRef       *v1.LocalObjectReference           `json:"secretRef,omitempty"`
+	Signals         []common.ObservabilitySignal       `json:"signals,omitempty"`
+	PersistentQueue *PersistentQueueApplyConfiguration `json:"persistentQueue,omitempty"`
 }
 
 // DestinationSpecApplyConfiguration constructs an declarative configuration of the DestinationSpec type for use with
@@ -85,3 +86,11 @@ func (b *DestinationSpecApplyConfiguration) WithSignals(values ...common.Observa
 	}
 	return b
 }
+
+// WithPersistentQueue sets the PersistentQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentQueue field is set to the value of the last call.
+func (b *DestinationSpecApplyConfiguration) WithPersistentQueue(value *PersistentQueueApplyConfiguration) *DestinationSpecApplyConfiguration {
+	b.PersistentQueue = value
+	return b
+}
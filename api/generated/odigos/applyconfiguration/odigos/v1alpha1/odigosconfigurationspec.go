@@ -38,6 +38,8 @@ type OdigosConfigurationSpecApplyConfiguration struct {
 	SupportedSDKs               map[common.ProgrammingLanguage][]common.OtelSdk  `json:"supportedSDKs,omitempty"`
 	DefaultSDKs                 map[common.ProgrammingLanguage]common.OtelSdk    `json:"defaultSDKs,omitempty"`
 	CollectorGateway            *CollectorGatewayConfigurationApplyConfiguration `json:"collectorGateway,omitempty"`
+	CollectorNode               *CollectorNodeConfigurationApplyConfiguration    `json:"collectorNode,omitempty"`
+	CollectorTLS                *CollectorTLSConfigurationApplyConfiguration     `json:"collectorTLS,omitempty"`
 	GoAutoIncludeCodeAttributes *bool                                            `json:"goAutoIncludeCodeAttributes,omitempty"`
 }
 
@@ -175,6 +177,22 @@ func (b *OdigosConfigurationSpecApplyConfiguration) WithCollectorGateway(value *
 	return b
 }
 
+// WithCollectorNode sets the CollectorNode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CollectorNode field is set to the value of the last call.
+func (b *OdigosConfigurationSpecApplyConfiguration) WithCollectorNode(value *CollectorNodeConfigurationApplyConfiguration) *OdigosConfigurationSpecApplyConfiguration {
+	b.CollectorNode = value
+	return b
+}
+
+// WithCollectorTLS sets the CollectorTLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CollectorTLS field is set to the value of the last call.
+func (b *OdigosConfigurationSpecApplyConfiguration) WithCollectorTLS(value *CollectorTLSConfigurationApplyConfiguration) *OdigosConfigurationSpecApplyConfiguration {
+	b.CollectorTLS = value
+	return b
+}
+
 // WithGoAutoIncludeCodeAttributes sets the GoAutoIncludeCodeAttributes field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the GoAutoIncludeCodeAttributes field is set to the value of the last call.
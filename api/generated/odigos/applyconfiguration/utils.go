@@ -32,6 +32,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &odigosv1alpha1.AttributeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CollectorGatewayConfiguration"):
 		return &odigosv1alpha1.CollectorGatewayConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CollectorNodeConfiguration"):
+		return &odigosv1alpha1.CollectorNodeConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CollectorTLSConfiguration"):
+		return &odigosv1alpha1.CollectorTLSConfigurationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CollectorsGroup"):
 		return &odigosv1alpha1.CollectorsGroupApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CollectorsGroupSpec"):
@@ -68,10 +72,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &odigosv1alpha1.OdigosConfigurationSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OptionByContainer"):
 		return &odigosv1alpha1.OptionByContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PersistentQueue"):
+		return &odigosv1alpha1.PersistentQueueApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PersistentQueueStorage"):
+		return &odigosv1alpha1.PersistentQueueStorageApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Processor"):
 		return &odigosv1alpha1.ProcessorApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ProcessorSpec"):
 		return &odigosv1alpha1.ProcessorSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ProcessorStatus"):
+		return &odigosv1alpha1.ProcessorStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RuntimeDetailsByContainer"):
 		return &odigosv1alpha1.RuntimeDetailsByContainerApplyConfiguration{}
 
@@ -29,6 +29,18 @@ type DestinationSpec struct {
 	Data            map[string]string            `json:"data"`
 	SecretRef       *v1.LocalObjectReference     `json:"secretRef,omitempty"`
 	Signals         []common.ObservabilitySignal `json:"signals"`
+
+	// PersistentQueue makes the gateway queue the telemetry of this destination on disk,
+	// so it is not dropped when the backend is unavailable for longer than the in-memory queue can hold.
+	// +optional
+	PersistentQueue *PersistentQueue `json:"persistentQueue,omitempty"`
+}
+
+type PersistentQueue struct {
+	// QueueSize is the maximum number of batches queued on disk for the destination.
+	// if not set, 5000 batches are queued.
+	// +kubebuilder:validation:Minimum=0
+	QueueSize int `json:"queueSize,omitempty"`
 }
 
 // DestinationStatus defines the observed state of Destination
@@ -76,3 +88,11 @@ func (dest Destination) GetConfig() map[string]string {
 func (dest Destination) GetSignals() []common.ObservabilitySignal {
 	return dest.Spec.Signals
 }
+
+/* Implement config.PersistentQueueConfigurer */
+func (dest Destination) GetPersistentQueue() (enabled bool, queueSize int) {
+	if dest.Spec.PersistentQueue == nil {
+		return false, 0
+	}
+	return true, dest.Spec.PersistentQueue.QueueSize
+}
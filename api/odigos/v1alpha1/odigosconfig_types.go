@@ -15,18 +15,118 @@ type CollectorGatewayConfiguration struct {
 
 	// this parameter sets the "limit_mib" parameter in the memory limiter configuration for the collector gateway.
 	// it is the hard limit after which a force garbage collection will be performed.
-	// if not set, it will be 50Mi below the memory request.
+	// if not set, it will be 50Mi below the memory request, or 80% of the memory request if the memory request is 50Mi or less.
 	MemoryLimiterLimitMiB int `json:"memoryLimiterLimitMiB,omitempty"`
 
 	// this parameter sets the "spike_limit_mib" parameter in the memory limiter configuration for the collector gateway.
 	// note that this is not the processor soft limit, but the diff in Mib between the hard limit and the soft limit.
 	// if not set, this will be set to 20% of the hard limit (so the soft limit will be 80% of the hard limit).
+	// a value that is not lower than the hard limit is ignored.
 	MemoryLimiterSpikeLimitMiB int `json:"memoryLimiterSpikeLimitMiB,omitempty"`
 
 	// the GOMEMLIMIT environment variable value for the collector gateway deployment.
 	// this is when go runtime will start garbage collection.
 	// if not specified, it will be set to 80% of the hard limit of the memory limiter.
 	GoMemLimitMib int `json:"goMemLimitMiB,omitempty"`
+
+	// MinReplicas is the minimum number of replicas the cluster gateway collector deployment is scaled to.
+	// default value is 1
+	MinReplicas int `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas the cluster gateway collector deployment is scaled to.
+	// default value is 10, and it is raised to MinReplicas if set lower.
+	MaxReplicas int `json:"maxReplicas,omitempty"`
+
+	// RequestCPUm is the cpu request for the cluster gateway collector deployment, in millicores.
+	// it will be embedded in the deployment as a resource request of the form "cpu: <value>m"
+	// if not set, no cpu is requested, unless a cpu utilization target is set, in which case it defaults to 500m.
+	RequestCPUm int `json:"requestCPUm,omitempty"`
+
+	// TargetCPUUtilizationPercent scales the cluster gateway collector deployment on the average cpu utilization
+	// of the replicas, as percentage of the cpu request, in addition to the memory usage.
+	// if not set, the deployment is scaled on memory only.
+	TargetCPUUtilizationPercent int `json:"targetCPUUtilizationPercent,omitempty"`
+
+	// ScaleUpStabilizationWindowSeconds is the window of past recommendations considered when scaling up.
+	// if not set, the kubernetes default of 0 seconds is used.
+	ScaleUpStabilizationWindowSeconds *int32 `json:"scaleUpStabilizationWindowSeconds,omitempty"`
+
+	// ScaleDownStabilizationWindowSeconds is the window of past recommendations considered when scaling down.
+	// if not set, the kubernetes default of 300 seconds is used.
+	ScaleDownStabilizationWindowSeconds *int32 `json:"scaleDownStabilizationWindowSeconds,omitempty"`
+
+	// PersistentQueueStorage runs the cluster gateway collector as a statefulset, with a persistent volume claim for each replica
+	// the persistent queues of the destinations are stored on.
+	// the queues are then kept when the replicas are rolled out or rescheduled, and the queue of a replica that is scaled down
+	// is sent once it is scaled up again, as the claims are not deleted.
+	// if not set, the queues are stored on an emptyDir volume, and dropped whenever a gateway pod is deleted,
+	// including when the pods are rolled out after the destinations change.
+	PersistentQueueStorage *PersistentQueueStorage `json:"persistentQueueStorage,omitempty"`
+}
+
+type PersistentQueueStorage struct {
+	// StorageClassName is the storage class of the claims.
+	// if not set, the default storage class of the cluster is used.
+	StorageClassName string `json:"storageClassName,omitempty"`
+
+	// SizeMiB is the size of the claim of each replica.
+	// default value is 10240Mi. it only applies to claims created after it is set, existing claims are not resized.
+	SizeMiB int `json:"sizeMiB,omitempty"`
+}
+
+type CollectorNodeConfiguration struct {
+	// RequestMemoryMiB is the memory request for the node collector daemonset.
+	// it will be embedded in the daemonset as a resource request of the form "memory: <value>Mi"
+	// if not set, no memory is requested.
+	RequestMemoryMiB int `json:"requestMemoryMiB,omitempty"`
+
+	// LimitMemoryMiB is the memory limit for the node collector daemonset.
+	// it will be embedded in the daemonset as a resource limit of the form "memory: <value>Mi"
+	// if not set, the memory is not limited.
+	// if it is lower than the memory request, it is raised to the request.
+	LimitMemoryMiB int `json:"limitMemoryMiB,omitempty"`
+
+	// RequestCPUm is the cpu request for the node collector daemonset, in millicores.
+	// it will be embedded in the daemonset as a resource request of the form "cpu: <value>m"
+	// if not set, no cpu is requested.
+	RequestCPUm int `json:"requestCPUm,omitempty"`
+
+	// LimitCPUm is the cpu limit for the node collector daemonset, in millicores.
+	// it will be embedded in the daemonset as a resource limit of the form "cpu: <value>m"
+	// if not set, the cpu is not limited.
+	// if it is lower than the cpu request, it is raised to the request.
+	LimitCPUm int `json:"limitCPUm,omitempty"`
+
+	// this parameter sets the "limit_mib" parameter in the memory limiter configuration for the node collector.
+	// it is the hard limit after which a force garbage collection will be performed.
+	// if not set, it will be 50Mi below the memory the collector may use: the memory limit, or 2x the memory request if only the request is set.
+	// if neither the memory of the collector nor this value is set, no memory limiter is added to the node collector.
+	// it will be 80% of that memory if it is 50Mi or less, and a value above that memory is ignored.
+	MemoryLimiterLimitMiB int `json:"memoryLimiterLimitMiB,omitempty"`
+
+	// this parameter sets the "spike_limit_mib" parameter in the memory limiter configuration for the node collector.
+	// note that this is not the processor soft limit, but the diff in Mib between the hard limit and the soft limit.
+	// if not set, this will be set to 20% of the hard limit (so the soft limit will be 80% of the hard limit).
+	// a value that is not lower than the hard limit is ignored.
+	MemoryLimiterSpikeLimitMiB int `json:"memoryLimiterSpikeLimitMiB,omitempty"`
+
+	// the GOMEMLIMIT environment variable value for the node collector daemonset.
+	// this is when go runtime will start garbage collection.
+	// if not specified, it will be set to 80% of the hard limit of the memory limiter.
+	// it is not set when there is no memory limiter.
+	GoMemLimitMib int `json:"goMemLimitMiB,omitempty"`
+}
+
+// CollectorTLSConfiguration configures tls for the telemetry sent from the node collectors to the cluster gateway
+type CollectorTLSConfiguration struct {
+	// Enabled encrypts the telemetry between the node collectors and the cluster gateway, and compresses it.
+	Enabled bool `json:"enabled,omitempty"`
+
+	// SecretName is the name of a secret in the odigos namespace holding the "tls.crt" and "tls.key" of the gateway,
+	// and the "ca.crt" the node collectors use to verify it.
+	// if not set, the autoscaler issues a self-signed certificate in the "odigos-gateway-tls" secret and rotates it before it expires.
+	// the ca the autoscaler issues is kept when the certificate is rotated, and a new ca is added to the "ca.crt" trust bundle a week before it replaces it.
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // OdigosConfigurationSpec defines the desired state of OdigosConfiguration
@@ -45,6 +145,8 @@ type OdigosConfigurationSpec struct {
 	SupportedSDKs     map[common.ProgrammingLanguage][]common.OtelSdk `json:"supportedSDKs,omitempty"`
 	DefaultSDKs       map[common.ProgrammingLanguage]common.OtelSdk   `json:"defaultSDKs,omitempty"`
 	CollectorGateway  *CollectorGatewayConfiguration                  `json:"collectorGateway,omitempty"`
+	CollectorNode     *CollectorNodeConfiguration                     `json:"collectorNode,omitempty"`
+	CollectorTLS      *CollectorTLSConfiguration                      `json:"collectorTLS,omitempty"`
 
 	// this is internal currently, and is not exposed on the CLI / helm
 	// used for odigos enterprise
@@ -66,6 +66,9 @@ type ProcessorSpec struct {
 
 // ProcessorStatus defines the observed state of the processor
 type ProcessorStatus struct {
+	// Represents the observations of a processor's current state.
+	// there is a condition for each collector role, telling if the processor is rendered into the collector config, or why it is skipped.
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" protobuf:"bytes,1,rep,name=conditions"`
 }
 
 //+genclient
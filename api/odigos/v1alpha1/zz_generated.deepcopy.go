@@ -45,6 +45,21 @@ func (in *Attribute) DeepCopy() *Attribute {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorGatewayConfiguration) DeepCopyInto(out *CollectorGatewayConfiguration) {
 	*out = *in
+	if in.ScaleUpStabilizationWindowSeconds != nil {
+		in, out := &in.ScaleUpStabilizationWindowSeconds, &out.ScaleUpStabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownStabilizationWindowSeconds != nil {
+		in, out := &in.ScaleDownStabilizationWindowSeconds, &out.ScaleDownStabilizationWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PersistentQueueStorage != nil {
+		in, out := &in.PersistentQueueStorage, &out.PersistentQueueStorage
+		*out = new(PersistentQueueStorage)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorGatewayConfiguration.
@@ -57,6 +72,36 @@ func (in *CollectorGatewayConfiguration) DeepCopy() *CollectorGatewayConfigurati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorNodeConfiguration) DeepCopyInto(out *CollectorNodeConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorNodeConfiguration.
+func (in *CollectorNodeConfiguration) DeepCopy() *CollectorNodeConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorNodeConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorTLSConfiguration) DeepCopyInto(out *CollectorTLSConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorTLSConfiguration.
+func (in *CollectorTLSConfiguration) DeepCopy() *CollectorTLSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorTLSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorsGroup) DeepCopyInto(out *CollectorsGroup) {
 	*out = *in
@@ -240,6 +285,11 @@ func (in *DestinationSpec) DeepCopyInto(out *DestinationSpec) {
 		*out = make([]common.ObservabilitySignal, len(*in))
 		copy(*out, *in)
 	}
+	if in.PersistentQueue != nil {
+		in, out := &in.PersistentQueue, &out.PersistentQueue
+		*out = new(PersistentQueue)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationSpec.
@@ -754,6 +804,16 @@ func (in *OdigosConfigurationSpec) DeepCopyInto(out *OdigosConfigurationSpec) {
 	if in.CollectorGateway != nil {
 		in, out := &in.CollectorGateway, &out.CollectorGateway
 		*out = new(CollectorGatewayConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CollectorNode != nil {
+		in, out := &in.CollectorNode, &out.CollectorNode
+		*out = new(CollectorNodeConfiguration)
+		**out = **in
+	}
+	if in.CollectorTLS != nil {
+		in, out := &in.CollectorTLS, &out.CollectorTLS
+		*out = new(CollectorTLSConfiguration)
 		**out = **in
 	}
 }
@@ -790,13 +850,43 @@ func (in *OptionByContainer) DeepCopy() *OptionByContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentQueue) DeepCopyInto(out *PersistentQueue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentQueue.
+func (in *PersistentQueue) DeepCopy() *PersistentQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentQueueStorage) DeepCopyInto(out *PersistentQueueStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentQueueStorage.
+func (in *PersistentQueueStorage) DeepCopy() *PersistentQueueStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentQueueStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Processor) DeepCopyInto(out *Processor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Processor.
@@ -878,6 +968,13 @@ func (in *ProcessorSpec) DeepCopy() *ProcessorSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProcessorStatus) DeepCopyInto(out *ProcessorStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProcessorStatus.
@@ -10,8 +10,11 @@ import (
 )
 
 var SamplingSupportedActions = map[reflect.Type]ActionHandler{
-	reflect.TypeOf(&actionv1.LatencySampler{}): &LatencySamplerHandler{},
-	reflect.TypeOf(&actionv1.ErrorSampler{}):   &ErrorSamplerHandler{},
+	reflect.TypeOf(&actionv1.LatencySampler{}):       &LatencySamplerHandler{},
+	reflect.TypeOf(&actionv1.ErrorSampler{}):         &ErrorSamplerHandler{},
+	reflect.TypeOf(&actionv1.ServiceNameSampler{}):   &ServiceNameSamplerHandler{},
+	reflect.TypeOf(&actionv1.SpanAttributeSampler{}): &SpanAttributeSamplerHandler{},
+	reflect.TypeOf(&actionv1.AdaptiveSampler{}):      &AdaptiveSamplerHandler{},
 	// Add more action types here
 }
 
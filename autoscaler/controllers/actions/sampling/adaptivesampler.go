@@ -0,0 +1,110 @@
+package sampling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	actionv1 "github.com/odigos-io/odigos/api/actions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type AdaptiveSamplerHandler struct{}
+
+type AdaptiveConfig struct {
+	ServiceName           string  `json:"service_name,omitempty"`
+	TargetTracesPerSecond float64 `json:"target_traces_per_second"`
+	MinSamplingRatio      float64 `json:"min_sampling_ratio,omitempty"`
+	AdjustmentInterval    string  `json:"adjustment_interval,omitempty"`
+}
+
+func (h *AdaptiveSamplerHandler) List(ctx context.Context, c client.Client, namespace string) ([]metav1.Object, error) {
+	var list actionv1.AdaptiveSamplerList
+	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil && client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	items := make([]metav1.Object, len(list.Items))
+	for i, item := range list.Items {
+
+		items[i] = &item
+	}
+	return items, nil
+}
+
+func (h *AdaptiveSamplerHandler) IsActionDisabled(action metav1.Object) bool {
+	return action.(*actionv1.AdaptiveSampler).Spec.Disabled
+}
+
+func (h *AdaptiveSamplerHandler) ValidateRuleConfig(config []Rule) error {
+	for _, rule := range config {
+		if err := rule.Details.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *AdaptiveSamplerHandler) GetRuleConfig(action metav1.Object) []Rule {
+	adaptivesampler := action.(*actionv1.AdaptiveSampler)
+	spec := adaptivesampler.Spec
+
+	// an empty service name means the target applies to every service on its own
+	serviceNames := spec.ServiceNames
+	if len(serviceNames) == 0 {
+		serviceNames = []string{""}
+	}
+
+	actionRules := []Rule{}
+	for _, serviceName := range serviceNames {
+		adaptiveDetails := &AdaptiveConfig{
+			ServiceName:           serviceName,
+			TargetTracesPerSecond: spec.TargetTracesPerSecond,
+			MinSamplingRatio:      spec.MinSamplingRatio,
+			AdjustmentInterval:    spec.AdjustmentInterval,
+		}
+
+		// the rule name labels the sampling metrics, so it includes the action name to keep the series of each action apart
+		name := fmt.Sprintf("adaptive-%s", adaptivesampler.Name)
+		if serviceName != "" {
+			name = fmt.Sprintf("adaptive-%s-%s", adaptivesampler.Name, serviceName)
+		}
+
+		actionRules = append(actionRules, Rule{
+			Name:     name,
+			RuleType: AdaptiveRule,
+			Details:  adaptiveDetails,
+		})
+	}
+
+	return actionRules
+}
+
+func (h *AdaptiveSamplerHandler) GetActionReference(action metav1.Object) metav1.OwnerReference {
+	a := action.(*actionv1.AdaptiveSampler)
+	return metav1.OwnerReference{APIVersion: a.APIVersion, Kind: a.Kind, Name: a.Name, UID: a.UID}
+}
+
+func (h *AdaptiveSamplerHandler) GetActionScope(action metav1.Object) string {
+	return "service"
+}
+
+func (ac *AdaptiveConfig) Validate() error {
+	if ac.TargetTracesPerSecond <= 0 {
+		return errors.New("target_traces_per_second must be positive")
+	}
+	if ac.MinSamplingRatio < 0 || ac.MinSamplingRatio > 100 {
+		return errors.New("min_sampling_ratio must be between 0 and 100")
+	}
+	if ac.AdjustmentInterval != "" {
+		interval, err := time.ParseDuration(ac.AdjustmentInterval)
+		if err != nil {
+			return fmt.Errorf("invalid adjustment_interval: %w", err)
+		}
+		if interval <= 0 {
+			return errors.New("adjustment_interval must be positive")
+		}
+	}
+	return nil
+}
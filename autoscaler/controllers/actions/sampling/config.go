@@ -1,9 +1,15 @@
 package sampling
 
+const (
+	// DefaultDecisionWait is how long the sampling processor holds the spans of a trace before evaluating the rules
+	DefaultDecisionWait = "30s"
+)
+
 type SamplingConfig struct {
 	GlobalRules   []Rule `json:"global_rules,omitempty"`
 	ServiceRules  []Rule `json:"service_rules,omitempty"`
 	EndpointRules []Rule `json:"endpoint_rules,omitempty"`
+	DecisionWait  string `json:"decision_wait,omitempty"`
 }
 
 // Rule representes a rule in odigossampling processor rule
@@ -16,6 +22,9 @@ type Rule struct {
 type RuleType string
 
 const (
-	LatencyRule RuleType = "http_latency"
-	ErrorRule   RuleType = "error"
+	LatencyRule       RuleType = "http_latency"
+	ErrorRule         RuleType = "error"
+	ServiceNameRule   RuleType = "service_name"
+	SpanAttributeRule RuleType = "span_attribute"
+	AdaptiveRule      RuleType = "adaptive"
 )
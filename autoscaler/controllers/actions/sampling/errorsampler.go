@@ -3,6 +3,8 @@ package sampling
 import (
 	"context"
 	"errors"
+	"fmt"
+	"regexp"
 
 	actionv1 "github.com/odigos-io/odigos/api/actions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,9 +14,17 @@ import (
 type ErrorSamplerHandler struct{}
 
 type ErrorConfig struct {
-	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
+	FallbackSamplingRatio   float64  `json:"fallback_sampling_ratio"`
+	ServiceName             string   `json:"service_name,omitempty"`
+	HttpStatusCodes         []string `json:"http_status_codes,omitempty"`
+	ExcludedHttpStatusCodes []string `json:"excluded_http_status_codes,omitempty"`
+	ExceptionEvents         bool     `json:"exception_events,omitempty"`
+	ExceptionTypes          []string `json:"exception_types,omitempty"`
 }
 
+// httpStatusCodeRegex matches a status code (429), a class (5xx) or a range (500-504)
+var httpStatusCodeRegex = regexp.MustCompile(`^([1-5][0-9]{2}|[1-5][xX]{2}|[1-5][0-9]{2}-[1-5][0-9]{2})$`)
+
 func (h *ErrorSamplerHandler) List(ctx context.Context, c client.Client, namespace string) ([]metav1.Object, error) {
 	var list actionv1.ErrorSamplerList
 	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil && client.IgnoreNotFound(err) != nil {
@@ -39,12 +49,18 @@ func (h *ErrorSamplerHandler) ValidateRuleConfig(config []Rule) error {
 func (h *ErrorSamplerHandler) GetRuleConfig(action metav1.Object) []Rule {
 	a := action.(*actionv1.ErrorSampler)
 	errorDetails := &ErrorConfig{
-		FallbackSamplingRatio: a.Spec.FallbackSamplingRatio,
+		FallbackSamplingRatio:   a.Spec.FallbackSamplingRatio,
+		ServiceName:             a.Spec.ServiceName,
+		HttpStatusCodes:         a.Spec.HttpStatusCodes,
+		ExcludedHttpStatusCodes: a.Spec.ExcludedHttpStatusCodes,
+		ExceptionEvents:         a.Spec.ExceptionEvents,
+		ExceptionTypes:          a.Spec.ExceptionTypes,
 	}
 
+	// the rule name labels the sampling metrics, so it includes the action name to keep the series of each action apart
 	return []Rule{
 		{
-			Name:     "error-rule",
+			Name:     fmt.Sprintf("error-%s", a.Name),
 			RuleType: ErrorRule,
 			Details:  errorDetails,
 		},
@@ -64,5 +80,12 @@ func (ec *ErrorConfig) Validate() error {
 	if ec.FallbackSamplingRatio < 0 || ec.FallbackSamplingRatio > 100 {
 		return errors.New("fallback_sampling_ratio must be between 0 and 100")
 	}
+	for _, codes := range [][]string{ec.HttpStatusCodes, ec.ExcludedHttpStatusCodes} {
+		for _, code := range codes {
+			if !httpStatusCodeRegex.MatchString(code) {
+				return fmt.Errorf("invalid http status code %q, expected a code (429), a class (5xx) or a range (500-504)", code)
+			}
+		}
+	}
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"regexp"
 
 	actionv1 "github.com/odigos-io/odigos/api/actions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +17,8 @@ type LatencySamplerHandler struct{}
 type LatencyConfig struct {
 	ThresholdMs           int     `json:"threshold"`
 	HttpRoute             string  `json:"http_route"`
+	MatchKey              string  `json:"match_key,omitempty"`
+	MatchType             string  `json:"match_type,omitempty"`
 	ServiceName           string  `json:"service_name"`
 	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
 }
@@ -53,6 +57,8 @@ func (h *LatencySamplerHandler) GetRuleConfig(action metav1.Object) []Rule {
 		latencyDetails := &LatencyConfig{
 			ThresholdMs:           config.MinimumLatencyThreshold,
 			HttpRoute:             config.HttpRoute,
+			MatchKey:              config.MatchKey,
+			MatchType:             config.MatchType,
 			ServiceName:           config.ServiceName,
 			FallbackSamplingRatio: config.FallbackSamplingRatio,
 		}
@@ -90,5 +96,23 @@ func (lc *LatencyConfig) Validate() error {
 	if lc.ServiceName == "" {
 		return errors.New("service_name cannot be empty")
 	}
+	switch lc.MatchKey {
+	case "", "http.route", "rpc.method", "messaging.destination.name":
+	default:
+		return fmt.Errorf("unknown match_key %q, must be one of http.route, rpc.method, messaging.destination.name", lc.MatchKey)
+	}
+	switch lc.MatchType {
+	case "", "prefix":
+	case "glob":
+		if _, err := path.Match(lc.HttpRoute, ""); err != nil {
+			return fmt.Errorf("http_route is not a valid glob pattern: %w", err)
+		}
+	case "regex":
+		if _, err := regexp.Compile(lc.HttpRoute); err != nil {
+			return fmt.Errorf("http_route is not a valid regex: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown match_type %q, must be one of prefix, glob, regex", lc.MatchType)
+	}
 	return nil
 }
@@ -0,0 +1,101 @@
+package sampling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	actionv1 "github.com/odigos-io/odigos/api/actions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type SpanAttributeSamplerHandler struct{}
+
+type SpanAttributeConfig struct {
+	AttributeKey          string  `json:"attribute_key"`
+	Condition             string  `json:"condition"`
+	Value                 string  `json:"value,omitempty"`
+	FallbackSamplingRatio float64 `json:"fallback_sampling_ratio"`
+}
+
+func (h *SpanAttributeSamplerHandler) List(ctx context.Context, c client.Client, namespace string) ([]metav1.Object, error) {
+	var list actionv1.SpanAttributeSamplerList
+	if err := c.List(ctx, &list, client.InNamespace(namespace)); err != nil && client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	items := make([]metav1.Object, len(list.Items))
+	for i, item := range list.Items {
+
+		items[i] = &item
+	}
+	return items, nil
+}
+
+func (h *SpanAttributeSamplerHandler) IsActionDisabled(action metav1.Object) bool {
+	return action.(*actionv1.SpanAttributeSampler).Spec.Disabled
+}
+
+func (h *SpanAttributeSamplerHandler) ValidateRuleConfig(config []Rule) error {
+	for _, rule := range config {
+		if err := rule.Details.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *SpanAttributeSamplerHandler) GetRuleConfig(action metav1.Object) []Rule {
+	spanattributesampler := action.(*actionv1.SpanAttributeSampler)
+	actionRules := []Rule{}
+
+	for _, config := range spanattributesampler.Spec.AttributeFilters {
+		spanAttributeDetails := &SpanAttributeConfig{
+			AttributeKey:          config.AttributeKey,
+			Condition:             config.Condition,
+			Value:                 config.Value,
+			FallbackSamplingRatio: config.FallbackSamplingRatio,
+		}
+
+		actionRules = append(actionRules, Rule{
+			Name:     fmt.Sprintf("span-attribute-%s-%s", spanAttributeDetails.AttributeKey, spanAttributeDetails.Condition),
+			RuleType: SpanAttributeRule,
+			Details:  spanAttributeDetails,
+		})
+	}
+
+	return actionRules
+}
+
+func (h *SpanAttributeSamplerHandler) GetActionReference(action metav1.Object) metav1.OwnerReference {
+	a := action.(*actionv1.SpanAttributeSampler)
+	return metav1.OwnerReference{APIVersion: a.APIVersion, Kind: a.Kind, Name: a.Name, UID: a.UID}
+}
+
+func (h *SpanAttributeSamplerHandler) GetActionScope(action metav1.Object) string {
+	return "global"
+}
+
+func (sc *SpanAttributeConfig) Validate() error {
+	if sc.AttributeKey == "" {
+		return errors.New("attribute_key cannot be empty")
+	}
+	if sc.FallbackSamplingRatio < 0 || sc.FallbackSamplingRatio > 100 {
+		return errors.New("fallback_sampling_ratio must be between 0 and 100")
+	}
+	switch sc.Condition {
+	case "exists":
+	case "equals":
+		if sc.Value == "" {
+			return errors.New("value cannot be empty for the equals condition")
+		}
+	case "regex":
+		if _, err := regexp.Compile(sc.Value); err != nil {
+			return fmt.Errorf("value is not a valid regex: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown condition %q, must be one of exists, equals, regex", sc.Condition)
+	}
+	return nil
+}
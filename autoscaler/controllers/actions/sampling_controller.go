@@ -28,7 +28,10 @@ type OdigosSamplingReconciler struct {
 const (
 	// Processor types
 	SamplingProcessorType = "odigossampling"
-	GroupByTraceType      = "groupbytrace"
+
+	// groupByTraceProcessorName is the processor created by previous versions to assemble traces before sampling,
+	// the sampling processor now holds the spans of each trace itself
+	groupByTraceProcessorName = "groupbytrace-processor"
 )
 
 func (r *OdigosSamplingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -80,6 +83,7 @@ func (r *OdigosSamplingReconciler) syncOdigosSamplingProcessor(ctx context.Conte
 	var (
 		actionsReferences    []metav1.OwnerReference
 		globalActionsRules   []sampling.Rule
+		serviceActionsRules  []sampling.Rule
 		endpointActionsRules []sampling.Rule
 	)
 
@@ -94,6 +98,9 @@ func (r *OdigosSamplingReconciler) syncOdigosSamplingProcessor(ctx context.Conte
 			if actionScope == "global" {
 				globalActionsRules = append(globalActionsRules, handler.GetRuleConfig(action)...)
 			}
+			if actionScope == "service" {
+				serviceActionsRules = append(serviceActionsRules, handler.GetRuleConfig(action)...)
+			}
 			if actionScope == "endpoint" {
 				endpointActionsRules = append(endpointActionsRules, handler.GetRuleConfig(action)...)
 			}
@@ -104,7 +111,9 @@ func (r *OdigosSamplingReconciler) syncOdigosSamplingProcessor(ctx context.Conte
 
 	samplingConf := sampling.SamplingConfig{
 		EndpointRules: endpointActionsRules,
+		ServiceRules:  serviceActionsRules,
 		GlobalRules:   globalActionsRules,
+		DecisionWait:  sampling.DefaultDecisionWait,
 	}
 
 	samplingConfigJson, err := json.Marshal(samplingConf)
@@ -130,8 +139,7 @@ func (r *OdigosSamplingReconciler) syncOdigosSamplingProcessor(ctx context.Conte
 		},
 	}
 
-	groupByTraceProcessor := r.getGroupByTraceProcessor(namespace, actionsReferences)
-	if err := r.Patch(ctx, groupByTraceProcessor, client.Apply, client.FieldOwner("groupbytrace"), client.ForceOwnership); err != nil {
+	if err := r.deleteGroupByTraceProcessor(ctx, namespace); err != nil {
 		return err
 	}
 
@@ -226,31 +234,12 @@ func getConditions(obj metav1.Object) (*[]metav1.Condition, error) {
 	return conditions, nil
 }
 
-func (r *OdigosSamplingReconciler) getGroupByTraceProcessor(namespace string, actionsReferences []metav1.OwnerReference) *v1.Processor {
-
-	grouByAttributeConfig := sampling.GroupByTraceConfig{
-		WaitDuration: sampling.DefaultWaitDuraiton,
-	}
-	groupByTraceConfigJson, err := json.Marshal(grouByAttributeConfig)
-	if err != nil {
-		return nil
-	}
-
-	return &v1.Processor{
-		TypeMeta: metav1.TypeMeta{APIVersion: "odigos.io/v1alpha1", Kind: "Processor"},
+func (r *OdigosSamplingReconciler) deleteGroupByTraceProcessor(ctx context.Context, namespace string) error {
+	groupByTraceProcessor := &v1.Processor{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            "groupbytrace-processor",
-			Namespace:       namespace,
-			OwnerReferences: actionsReferences,
-		},
-		Spec: v1.ProcessorSpec{
-			Type:            GroupByTraceType,
-			ProcessorName:   GroupByTraceType,
-			Disabled:        false, // In case related actions are disabled, the processor wont be created
-			Signals:         []common.ObservabilitySignal{common.TracesObservabilitySignal},
-			CollectorRoles:  []v1.CollectorsGroupRole{v1.CollectorsGroupRoleClusterGateway},
-			OrderHint:       -25,
-			ProcessorConfig: runtime.RawExtension{Raw: groupByTraceConfigJson},
+			Name:      groupByTraceProcessorName,
+			Namespace: namespace,
 		},
 	}
+	return client.IgnoreNotFound(r.Delete(ctx, groupByTraceProcessor))
 }
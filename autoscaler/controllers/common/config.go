@@ -21,3 +21,25 @@ func ToExporterConfigurerArray(dests *odigosv1.DestinationList) []config.Exporte
 	}
 	return configurers
 }
+
+// tlsSecretDestination is a destination with the keys of the tls secret it references
+type tlsSecretDestination struct {
+	odigosv1.Destination
+	secretKeys map[string]bool
+}
+
+func (dest tlsSecretDestination) GetTLSSecretKeys() map[string]bool {
+	return dest.secretKeys
+}
+
+// ToExporterConfigurerArrayWithTLSSecrets converts the destinations like ToExporterConfigurerArray, with the keys of the tls
+// secrets they reference by secret name. A secret missing from secretKeys is reported as not found
+func ToExporterConfigurerArrayWithTLSSecrets(dests *odigosv1.DestinationList, secretKeys map[string]map[string]bool) []config.ExporterConfigurer {
+	configurers := ToExporterConfigurerArray(dests)
+	for i, dest := range dests.Items {
+		for _, secretName := range config.TLSSecretNames(configurers[i : i+1]) {
+			configurers[i] = tlsSecretDestination{Destination: dest, secretKeys: secretKeys[secretName]}
+		}
+	}
+	return configurers
+}
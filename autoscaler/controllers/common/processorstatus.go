@@ -0,0 +1,122 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/autoscaler/controllers/validation"
+	"github.com/odigos-io/odigos/common/config"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// the condition type on the processor status for each collector role
+var processorConfiguredTypes = map[odigosv1.CollectorsGroupRole]string{
+	odigosv1.CollectorsGroupRoleClusterGateway: "ClusterGatewayConfigured",
+	odigosv1.CollectorsGroupRoleNodeCollector:  "NodeCollectorConfigured",
+}
+
+// UpdateProcessorsStatus sets a condition on each processor telling if it is rendered into the config of the collector role,
+// or why it is skipped.
+// collectorConfig is the config calculated for the collector, processorErrs are the errors of processors keyed by their id,
+// and configErr is set when the config is invalid and is not rolled out.
+func UpdateProcessorsStatus(ctx context.Context, c client.Client, processors *odigosv1.ProcessorList, role odigosv1.CollectorsGroupRole,
+	collectorConfig string, processorErrs map[string]error, configErr error) {
+	logger := log.FromContext(ctx)
+	conditionType := processorConfiguredTypes[role]
+	inPipelines := processorsInPipelines(collectorConfig)
+
+	for i := range processors.Items {
+		processor := &processors.Items[i]
+		// processors added in memory, like the generic batch processor, are not stored in k8s and have no status
+		if processor.UID == "" {
+			continue
+		}
+
+		status, reason, msg := processorCondition(processor, role, inPipelines, processorErrs, configErr)
+		cond := metav1.Condition{
+			Type:    conditionType,
+			Status:  status,
+			Reason:  reason,
+			Message: msg,
+		}
+		if err := updateProcessorCondition(ctx, c, processor, cond); err != nil {
+			logger.Error(err, "Failed to update processor status conditions", "processor", processor.Name)
+		}
+	}
+}
+
+// updateProcessorCondition sets the condition on the latest version of the processor.
+// the gateway and node collector syncs set their conditions on the same processors concurrently,
+// so the processor is read again and the update retried when it conflicts with the other sync
+func updateProcessorCondition(ctx context.Context, c client.Client, processor *odigosv1.Processor, cond metav1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest odigosv1.Processor
+		if err := c.Get(ctx, client.ObjectKeyFromObject(processor), &latest); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		cond.ObservedGeneration = latest.Generation
+		if !meta.SetStatusCondition(&latest.Status.Conditions, cond) {
+			return nil
+		}
+		if err := c.Status().Update(ctx, &latest); err != nil {
+			return err
+		}
+		latest.DeepCopyInto(processor)
+		return nil
+	})
+}
+
+func processorCondition(processor *odigosv1.Processor, role odigosv1.CollectorsGroupRole, inPipelines map[string]bool,
+	processorErrs map[string]error, configErr error) (metav1.ConditionStatus, string, string) {
+	if !hasCollectorRole(processor, role) {
+		return metav1.ConditionFalse, "CollectorRoleNotSelected", fmt.Sprintf("processor is not configured to run in the %s collector", role)
+	}
+	if processor.Spec.Disabled {
+		return metav1.ConditionFalse, "ProcessorDisabled", "processor is disabled"
+	}
+	if err := processorErrs[processor.GetID()]; err != nil {
+		return metav1.ConditionFalse, validation.ErrorReason(err, "ErrConfigProcessor"), err.Error()
+	}
+	if configErr != nil {
+		return metav1.ConditionFalse, "CollectorConfigRejected", "the collector config is invalid because of other resources, the last valid config is kept: " + configErr.Error()
+	}
+	if !inPipelines[config.ProcessorKey(processor)] {
+		return metav1.ConditionFalse, "NoMatchingPipeline", "processor is not added to any pipeline, as no pipeline exists for its signals"
+	}
+	return metav1.ConditionTrue, "RenderedInCollectorConfig", "processor successfully rendered into the collector config"
+}
+
+func hasCollectorRole(processor *odigosv1.Processor, role odigosv1.CollectorsGroupRole) bool {
+	for _, r := range processor.Spec.CollectorRoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// processorsInPipelines returns the names of the processors used by the pipelines of the collector config
+func processorsInPipelines(collectorConfig string) map[string]bool {
+	processors := map[string]bool{}
+	var cfg config.Config
+	if err := yaml.Unmarshal([]byte(collectorConfig), &cfg); err != nil {
+		return processors
+	}
+	for _, pipeline := range cfg.Service.Pipelines {
+		for _, processor := range pipeline.Processors {
+			processors[processor] = true
+		}
+	}
+	return processors
+}
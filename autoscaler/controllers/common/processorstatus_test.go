@@ -0,0 +1,148 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testCollectorConfig = `
+service:
+  pipelines:
+    traces/otlp:
+      processors:
+        - batch/valid
+`
+
+func newTestProcessor(name string, roles ...odigosv1.CollectorsGroupRole) odigosv1.Processor {
+	return odigosv1.Processor{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "odigos-system", UID: types.UID("uid-" + name)},
+		Spec: odigosv1.ProcessorSpec{
+			Type:           "batch",
+			CollectorRoles: roles,
+		},
+	}
+}
+
+func TestProcessorCondition(t *testing.T) {
+	gateway := odigosv1.CollectorsGroupRoleClusterGateway
+	inPipelines := processorsInPipelines(testCollectorConfig)
+
+	disabled := newTestProcessor("valid", gateway)
+	disabled.Spec.Disabled = true
+
+	tests := []struct {
+		name           string
+		processor      odigosv1.Processor
+		processorErrs  map[string]error
+		configErr      error
+		expectedStatus metav1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "rendered",
+			processor:      newTestProcessor("valid", gateway),
+			expectedStatus: metav1.ConditionTrue,
+			expectedReason: "RenderedInCollectorConfig",
+		},
+		{
+			name:           "other collector role",
+			processor:      newTestProcessor("valid", odigosv1.CollectorsGroupRoleNodeCollector),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "CollectorRoleNotSelected",
+		},
+		{
+			name:           "disabled",
+			processor:      disabled,
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "ProcessorDisabled",
+		},
+		{
+			name:           "invalid processor",
+			processor:      newTestProcessor("valid", gateway),
+			processorErrs:  map[string]error{"valid": errors.New("bad config")},
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "ErrConfigProcessor",
+		},
+		{
+			name:           "collector config rejected",
+			processor:      newTestProcessor("valid", gateway),
+			configErr:      errors.New("bad exporter"),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "CollectorConfigRejected",
+		},
+		{
+			name:           "not in any pipeline",
+			processor:      newTestProcessor("other", gateway),
+			expectedStatus: metav1.ConditionFalse,
+			expectedReason: "NoMatchingPipeline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reason, _ := processorCondition(&tt.processor, gateway, inPipelines, tt.processorErrs, tt.configErr)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedReason, reason)
+		})
+	}
+}
+
+func TestUpdateProcessorsStatus(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, odigosv1.AddToScheme(scheme))
+
+	valid := newTestProcessor("valid", odigosv1.CollectorsGroupRoleClusterGateway, odigosv1.CollectorsGroupRoleNodeCollector)
+	invalid := newTestProcessor("invalid", odigosv1.CollectorsGroupRoleClusterGateway, odigosv1.CollectorsGroupRoleNodeCollector)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&odigosv1.Processor{}).WithObjects(&valid, &invalid).Build()
+
+	var processors odigosv1.ProcessorList
+	require.NoError(t, c.List(ctx, &processors))
+	// the gateway and node collector syncs each hold their own list of the processors
+	gatewayProcessors := processors.DeepCopy()
+	nodeProcessors := processors.DeepCopy()
+	processorErrs := map[string]error{"invalid": errors.New("bad config")}
+
+	getProcessor := func(name string) odigosv1.Processor {
+		var p odigosv1.Processor
+		require.NoError(t, c.Get(ctx, client.ObjectKey{Name: name, Namespace: "odigos-system"}, &p))
+		return p
+	}
+
+	UpdateProcessorsStatus(ctx, c, gatewayProcessors, odigosv1.CollectorsGroupRoleClusterGateway, testCollectorConfig, processorErrs, nil)
+	// the node collector list is stale after the gateway update, its conditions must not drop the ones of the gateway
+	UpdateProcessorsStatus(ctx, c, nodeProcessors, odigosv1.CollectorsGroupRoleNodeCollector, testCollectorConfig, processorErrs, nil)
+
+	validStatus := getProcessor("valid")
+	for _, conditionType := range []string{"ClusterGatewayConfigured", "NodeCollectorConfigured"} {
+		cond := meta.FindStatusCondition(validStatus.Status.Conditions, conditionType)
+		require.NotNil(t, cond, conditionType)
+		assert.Equal(t, metav1.ConditionTrue, cond.Status)
+		assert.Equal(t, "RenderedInCollectorConfig", cond.Reason)
+	}
+
+	invalidStatus := getProcessor("invalid")
+	for _, conditionType := range []string{"ClusterGatewayConfigured", "NodeCollectorConfigured"} {
+		cond := meta.FindStatusCondition(invalidStatus.Status.Conditions, conditionType)
+		require.NotNil(t, cond, conditionType)
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "ErrConfigProcessor", cond.Reason)
+		assert.Equal(t, "bad config", cond.Message)
+	}
+
+	// unchanged conditions are not written again
+	UpdateProcessorsStatus(ctx, c, processors.DeepCopy(), odigosv1.CollectorsGroupRoleClusterGateway, testCollectorConfig, processorErrs, nil)
+	assert.Equal(t, validStatus.ResourceVersion, getProcessor("valid").ResourceVersion)
+	assert.Equal(t, invalidStatus.ResourceVersion, getProcessor("invalid").ResourceVersion)
+}
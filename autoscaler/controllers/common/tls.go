@@ -0,0 +1,124 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CollectorTLSSecretName is the secret holding the certificate issued by the autoscaler for the gateway
+	CollectorTLSSecretName = "odigos-gateway-tls"
+	// CollectorTLSDirectory is where the collectors mount the certificates of the node collector to gateway traffic
+	CollectorTLSDirectory = "/etc/odigos/collector-tls"
+	// CollectorTLSHashAnnotation restarts the collectors when the certificates are rotated
+	CollectorTLSHashAnnotation = "odigos.io/collector-tls-hash"
+
+	// CollectorTLSCAKey is the key of the ca certificate in the secret, along the kubernetes.io/tls keys
+	CollectorTLSCAKey = "ca.crt"
+
+	collectorTLSVolume = "collector-tls"
+)
+
+// CollectorTLS is the certificate securing the telemetry sent from the node collectors to the gateway
+type CollectorTLS struct {
+	SecretName string
+	// the hash of each key of the secret, so the pods are restarted only when the keys they mount are rotated
+	keyHashes map[string]string
+}
+
+func (t *CollectorTLS) CAFile() string {
+	return fmt.Sprintf("%s/%s", CollectorTLSDirectory, CollectorTLSCAKey)
+}
+
+func (t *CollectorTLS) CertFile() string {
+	return fmt.Sprintf("%s/%s", CollectorTLSDirectory, corev1.TLSCertKey)
+}
+
+func (t *CollectorTLS) KeyFile() string {
+	return fmt.Sprintf("%s/%s", CollectorTLSDirectory, corev1.TLSPrivateKeyKey)
+}
+
+// CollectorTLSServerName is the name of the gateway service the node collectors verify in its certificate
+func CollectorTLSServerName(namespace string) string {
+	return fmt.Sprintf("odigos-gateway.%s.svc", namespace)
+}
+
+// CollectorTLSSecretNameFromConfig returns the name of the secret of the collectors certificate, or empty if tls is disabled
+func CollectorTLSSecretNameFromConfig(odigosConfig *odigosv1.OdigosConfiguration) string {
+	tlsConfig := odigosConfig.Spec.CollectorTLS
+	if tlsConfig == nil || !tlsConfig.Enabled {
+		return ""
+	}
+	if tlsConfig.SecretName != "" {
+		return tlsConfig.SecretName
+	}
+	return CollectorTLSSecretName
+}
+
+// GetCollectorTLS reads the certificate of the collectors from the secret, nil if tls is disabled
+func GetCollectorTLS(ctx context.Context, c client.Client, namespace string, odigosConfig *odigosv1.OdigosConfiguration) (*CollectorTLS, error) {
+	secretName := CollectorTLSSecretNameFromConfig(odigosConfig)
+	if secretName == "" {
+		return nil, nil
+	}
+
+	var secret corev1.Secret
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
+		return nil, err
+	}
+	return CollectorTLSFromSecret(&secret)
+}
+
+// CollectorTLSFromSecret validates the secret holds the certificates the collectors need
+func CollectorTLSFromSecret(secret *corev1.Secret) (*CollectorTLS, error) {
+	keys := []string{CollectorTLSCAKey, corev1.TLSCertKey, corev1.TLSPrivateKeyKey}
+	for _, key := range keys {
+		if len(secret.Data[key]) == 0 {
+			return nil, fmt.Errorf("secret %s is missing %s for the collectors tls", secret.Name, key)
+		}
+	}
+
+	keyHashes := make(map[string]string, len(keys))
+	for _, key := range keys {
+		keyHashes[key] = Sha256Hash(string(secret.Data[key]))
+	}
+
+	return &CollectorTLS{
+		SecretName: secret.Name,
+		keyHashes:  keyHashes,
+	}, nil
+}
+
+// MountCollectorTLS mounts the keys of the certificates secret in the collector container, and restarts the pods when these keys are rotated
+func MountCollectorTLS(template *corev1.PodTemplateSpec, collectorTLS *CollectorTLS, keys ...string) {
+	items := make([]corev1.KeyToPath, 0, len(keys))
+	var keyHashes string
+	for _, key := range keys {
+		items = append(items, corev1.KeyToPath{Key: key, Path: key})
+		keyHashes += collectorTLS.keyHashes[key]
+	}
+
+	template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+		Name: collectorTLSVolume,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: collectorTLS.SecretName,
+				Items:      items,
+			},
+		},
+	})
+	template.Spec.Containers[0].VolumeMounts = append(template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      collectorTLSVolume,
+		MountPath: CollectorTLSDirectory,
+		ReadOnly:  true,
+	})
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[CollectorTLSHashAnnotation] = Sha256Hash(keyHashes)
+}
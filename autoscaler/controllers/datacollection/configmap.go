@@ -11,6 +11,7 @@ import (
 	"github.com/ghodss/yaml"
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/autoscaler/controllers/validation"
 	"github.com/odigos-io/odigos/common/config"
 	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	v1 "k8s.io/api/core/v1"
@@ -28,7 +29,7 @@ const (
 
 func syncConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.DestinationList, allProcessors *odigosv1.ProcessorList,
 	datacollection *odigosv1.CollectorsGroup, ctx context.Context,
-	c client.Client, scheme *runtime.Scheme) (string, error) {
+	c client.Client, scheme *runtime.Scheme, collectorTLS *commonconf.CollectorTLS, resourceConfig *resourceConfigurations) (string, error) {
 	logger := log.FromContext(ctx)
 
 	processors := commonconf.FilterAndSortProcessorsByOrderHint(allProcessors, odigosv1.CollectorsGroupRoleNodeCollector)
@@ -37,16 +38,26 @@ func syncConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.D
 	SamplingExists := commonconf.FindFirstProcessorByType(allProcessors, "odigossampling")
 	setTracesLoadBalancer := SamplingExists != nil
 
-	desired, err := getDesiredConfigMap(apps, dests, processors, datacollection, scheme, setTracesLoadBalancer)
-	desiredData := desired.Data[configKey]
+	desired, err := getDesiredConfigMap(apps, dests, processors, datacollection, scheme, setTracesLoadBalancer, collectorTLS, resourceConfig)
 	if err != nil {
 		logger.Error(err, "failed to get desired config map")
 		return "", err
 	}
+	desiredData := desired.Data[configKey]
+
+	// an invalid config would crash the collector on every node, so it is not rolled out
+	processorErrs, validationErr := validateConfig(desiredData, processors)
+	if validationErr != nil {
+		logger.Error(validationErr, "data collection config is invalid")
+	}
+	commonconf.UpdateProcessorsStatus(ctx, c, allProcessors, odigosv1.CollectorsGroupRoleNodeCollector, desiredData, processorErrs, validationErr)
 
 	existing := &v1.ConfigMap{}
 	if err := c.Get(ctx, client.ObjectKey{Namespace: datacollection.Namespace, Name: datacollection.Name}, existing); err != nil {
 		if apierrors.IsNotFound(err) {
+			if validationErr != nil {
+				return "", validationErr
+			}
 			logger.V(0).Info("creating config map")
 			_, err := createConfigMap(desired, ctx, c)
 			if err != nil {
@@ -60,6 +71,11 @@ func syncConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.D
 		}
 	}
 
+	if validationErr != nil {
+		logger.V(0).Info("keeping the last valid config map")
+		return existing.Data[configKey], nil
+	}
+
 	logger.V(0).Info("Patching config map")
 	_, err = patchConfigMap(ctx, existing, desired, c)
 	if err != nil {
@@ -70,6 +86,30 @@ func syncConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.D
 	return desiredData, nil
 }
 
+// validateConfig validates the node collector config, and returns the errors of the processors keyed by their id,
+// including those that made the config invalid
+func validateConfig(data string, processors []*odigosv1.Processor) (map[string]error, error) {
+	processorConfigurers := commonconf.ToProcessorConfigurerArray(processors)
+	_, _, _, _, processorErrs := config.GetCrdProcessorsConfigMap(processorConfigurers)
+	status := &config.ResourceStatuses{
+		Destination:         map[string]error{},
+		Processor:           map[string]error{},
+		ProcessorComponents: map[string]string{},
+	}
+	for id, err := range processorErrs {
+		status.Processor[id] = err
+	}
+	for _, processor := range processorConfigurers {
+		status.ProcessorComponents[config.ComponentKey("processors", config.ProcessorKey(processor))] = processor.GetID()
+	}
+
+	err := validation.ValidateConfigWithStatus(data, status)
+	for name, processorErr := range status.Processor {
+		log.Log.V(0).Info(processorErr.Error(), "processor", name)
+	}
+	return status.Processor, err
+}
+
 func patchConfigMap(ctx context.Context, existing *v1.ConfigMap, desired *v1.ConfigMap, c client.Client) (*v1.ConfigMap, error) {
 	if reflect.DeepEqual(existing.Data, desired.Data) &&
 		reflect.DeepEqual(existing.ObjectMeta.OwnerReferences, desired.ObjectMeta.OwnerReferences) {
@@ -96,8 +136,9 @@ func createConfigMap(desired *v1.ConfigMap, ctx context.Context, c client.Client
 }
 
 func getDesiredConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.DestinationList, processors []*odigosv1.Processor,
-	datacollection *odigosv1.CollectorsGroup, scheme *runtime.Scheme, setTracesLoadBalancer bool) (*v1.ConfigMap, error) {
-	cmData, err := getConfigMapData(apps, dests, processors, setTracesLoadBalancer)
+	datacollection *odigosv1.CollectorsGroup, scheme *runtime.Scheme, setTracesLoadBalancer bool, collectorTLS *commonconf.CollectorTLS,
+	resourceConfig *resourceConfigurations) (*v1.ConfigMap, error) {
+	cmData, err := getConfigMapData(apps, dests, processors, setTracesLoadBalancer, collectorTLS, resourceConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +153,8 @@ func getDesiredConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odig
 		},
 	}
 
-	if custom.ShouldApplyCustomDataCollection(dests) {
-		custom.AddCustomConfigMap(dests, &desired)
+	if err := custom.ModifyConfigMap(dests, &desired); err != nil {
+		return nil, err
 	}
 
 	if err := ctrl.SetControllerReference(datacollection, &desired, scheme); err != nil {
@@ -124,16 +165,23 @@ func getDesiredConfigMap(apps *odigosv1.InstrumentedApplicationList, dests *odig
 }
 
 func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.DestinationList, processors []*odigosv1.Processor,
-	setTracesLoadBalancer bool) (string, error) {
+	setTracesLoadBalancer bool, collectorTLS *commonconf.CollectorTLS, resourceConfig *resourceConfigurations) (string, error) {
 
 	empty := struct{}{}
 
-	processorsCfg, tracesProcessors, metricsProcessors, logsProcessors, errs := config.GetCrdProcessorsConfigMap(commonconf.ToProcessorConfigurerArray(processors))
-	if errs != nil {
-		for name, err := range errs {
-			log.Log.V(0).Info(err.Error(), "processor", name)
+	// the errors of processors are reported when the config is validated
+	processorsCfg, tracesProcessors, metricsProcessors, logsProcessors, _ := config.GetCrdProcessorsConfigMap(commonconf.ToProcessorConfigurerArray(processors))
+	// the memory limiter runs first in every pipeline, and only when the memory of the collector is configured
+	var pipelineProcessors []string
+	if resourceConfig.memoryLimiterLimitMiB > 0 {
+		processorsCfg["memory_limiter"] = config.GenericMap{
+			"check_interval":  "1s",
+			"limit_mib":       resourceConfig.memoryLimiterLimitMiB,
+			"spike_limit_mib": resourceConfig.memoryLimiterSpikeLimitMiB,
 		}
+		pipelineProcessors = append(pipelineProcessors, "memory_limiter")
 	}
+	pipelineProcessors = append(pipelineProcessors, "batch", "odigosresourcename", "resource", "resourcedetection")
 	processorsCfg["batch"] = empty
 	processorsCfg["odigosresourcename"] = empty
 	processorsCfg["resource"] = config.GenericMap{
@@ -145,19 +193,38 @@ func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv
 	}
 	processorsCfg["resourcedetection"] = config.GenericMap{"detectors": []string{"ec2", "gcp", "azure"}}
 
-	exporters := config.GenericMap{
-		"otlp/gateway": config.GenericMap{
-			"endpoint": fmt.Sprintf("dns:///odigos-gateway.%s:4317", env.GetCurrentNamespace()),
-			"tls": config.GenericMap{
-				"insecure": true,
-			},
+	gatewayExporter := config.GenericMap{
+		"endpoint": fmt.Sprintf("dns:///odigos-gateway.%s:4317", env.GetCurrentNamespace()),
+		"tls": config.GenericMap{
+			"insecure": true,
+		},
+	}
+	loadBalancingProtocol := config.GenericMap{
+		"tls": config.GenericMap{
+			"insecure": true,
 		},
 	}
+	if collectorTLS != nil {
+		gatewayExporter["tls"] = config.GenericMap{
+			"ca_file": collectorTLS.CAFile(),
+		}
+		gatewayExporter["compression"] = "gzip"
+		// the load balancer sends to the gateway pods by ip, so the name in the certificate is set explicitly
+		loadBalancingProtocol["tls"] = config.GenericMap{
+			"ca_file":              collectorTLS.CAFile(),
+			"server_name_override": commonconf.CollectorTLSServerName(env.GetCurrentNamespace()),
+		}
+		loadBalancingProtocol["compression"] = "gzip"
+	}
+
+	exporters := config.GenericMap{
+		"otlp/gateway": gatewayExporter,
+	}
 	tracesPipelineExporter := []string{"otlp/gateway"}
 
 	if setTracesLoadBalancer {
 		exporters["loadbalancing"] = config.GenericMap{
-			"protocol": config.GenericMap{"otlp": config.GenericMap{"tls": config.GenericMap{"insecure": true}}},
+			"protocol": config.GenericMap{"otlp": loadBalancingProtocol},
 			"resolver": config.GenericMap{"k8s": config.GenericMap{"service": fmt.Sprintf("odigos-gateway.%s", env.GetCurrentNamespace())}},
 		}
 		tracesPipelineExporter = []string{"loadbalancing"}
@@ -188,15 +255,20 @@ func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv
 	collectTraces := false
 	collectMetrics := false
 	collectLogs := false
-	for _, dst := range dests.Items {
+	for i := range dests.Items {
+		dst := &dests.Items[i]
 		for _, s := range dst.Spec.Signals {
-			if s == common.LogsObservabilitySignal && !custom.DestRequiresCustom(dst.Spec.Type) {
+			// signals collected for the destination on the node are not sent to it through the gateway
+			if custom.CollectsSignal(dst, s) {
+				continue
+			}
+			if s == common.LogsObservabilitySignal {
 				collectLogs = true
 			}
 			if s == common.TracesObservabilitySignal || dst.Spec.Type == common.PrometheusDestinationType {
 				collectTraces = true
 			}
-			if s == common.MetricsObservabilitySignal && !custom.DestRequiresCustom(dst.Spec.Type) {
+			if s == common.MetricsObservabilitySignal {
 				collectMetrics = true
 			}
 		}
@@ -326,7 +398,7 @@ func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv
 
 		cfg.Service.Pipelines["logs"] = config.Pipeline{
 			Receivers:  []string{"filelog"},
-			Processors: append([]string{"batch", "odigosresourcename", "resource", "resourcedetection"}, logsProcessors...),
+			Processors: append(append([]string{}, pipelineProcessors...), logsProcessors...),
 			Exporters:  []string{"otlp/gateway"},
 		}
 	}
@@ -334,7 +406,7 @@ func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv
 	if collectTraces {
 		cfg.Service.Pipelines["traces"] = config.Pipeline{
 			Receivers:  []string{"otlp", "zipkin"},
-			Processors: append([]string{"batch", "odigosresourcename", "resource", "resourcedetection"}, tracesProcessors...),
+			Processors: append(append([]string{}, pipelineProcessors...), tracesProcessors...),
 			Exporters:  tracesPipelineExporter,
 		}
 	}
@@ -349,11 +421,15 @@ func getConfigMapData(apps *odigosv1.InstrumentedApplicationList, dests *odigosv
 
 		cfg.Service.Pipelines["metrics"] = config.Pipeline{
 			Receivers:  []string{"otlp", "kubeletstats"},
-			Processors: append([]string{"batch", "odigosresourcename", "resource", "resourcedetection"}, metricsProcessors...),
+			Processors: append(append([]string{}, pipelineProcessors...), metricsProcessors...),
 			Exporters:  []string{"otlp/gateway"},
 		}
 	}
 
+	if err := custom.ModifyConfig(dests, &cfg); err != nil {
+		return "", err
+	}
+
 	data, err := yaml.Marshal(cfg)
 	if err != nil {
 		return "", err
@@ -6,7 +6,9 @@ import (
 
 	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
 	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -17,6 +19,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -149,8 +153,82 @@ func TestGetConfigMapData(t *testing.T) {
 				},
 			},
 		},
-		false)
+		false,
+		nil,
+		getResourceConfigurations(&v1alpha1.OdigosConfiguration{}))
 
 	assert.Equal(t, err, nil)
 	assert.Equal(t, want, got)
 }
+
+func TestGetConfigMapDataCollectorTLS(t *testing.T) {
+	collectorTLS := &commonconf.CollectorTLS{SecretName: "odigos-collector-tls"}
+	got, err := getConfigMapData(
+		&v1alpha1.InstrumentedApplicationList{},
+		NewMockDestinationList(),
+		nil,
+		true,
+		collectorTLS,
+		getResourceConfigurations(&v1alpha1.OdigosConfiguration{}))
+	require.NoError(t, err)
+
+	var cfg config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(got), &cfg))
+
+	// the gateway exporter verifies the gateway certificate with the ca, and compresses the data it sends
+	assert.Equal(t, config.GenericMap{
+		"endpoint":    "dns:///odigos-gateway.odigos-system:4317",
+		"tls":         config.GenericMap{"ca_file": "/etc/odigos/collector-tls/ca.crt"},
+		"compression": "gzip",
+	}, toGenericMap(cfg.Exporters["otlp/gateway"]))
+
+	// the load balancer sends to the gateway pods by ip, so it verifies the certificate against the service name
+	loadBalancing := toGenericMap(cfg.Exporters["loadbalancing"])
+	protocol := toGenericMap(toGenericMap(loadBalancing["protocol"])["otlp"])
+	assert.Equal(t, config.GenericMap{
+		"ca_file":              "/etc/odigos/collector-tls/ca.crt",
+		"server_name_override": commonconf.CollectorTLSServerName("odigos-system"),
+	}, toGenericMap(protocol["tls"]))
+	assert.Equal(t, "gzip", protocol["compression"])
+}
+
+func TestGetConfigMapDataInvalidNodeDestination(t *testing.T) {
+	honeycomb := func(name string, secretRef *corev1.LocalObjectReference) v1alpha1.Destination {
+		return v1alpha1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.DestinationSpec{
+				Type:      common.HoneycombDestinationType,
+				Signals:   []common.ObservabilitySignal{common.LogsObservabilitySignal},
+				SecretRef: secretRef,
+			},
+		}
+	}
+	render := func(dests ...v1alpha1.Destination) config.Config {
+		got, err := getConfigMapData(
+			&v1alpha1.InstrumentedApplicationList{},
+			&v1alpha1.DestinationList{Items: dests},
+			nil,
+			false,
+			nil,
+			getResourceConfigurations(&v1alpha1.OdigosConfiguration{}))
+		require.NoError(t, err)
+		var cfg config.Config
+		require.NoError(t, yaml.Unmarshal([]byte(got), &cfg))
+		return cfg
+	}
+
+	// the logs of a valid destination are collected for it on the node, not sent through the gateway
+	cfg := render(honeycomb("honeycomb", &corev1.LocalObjectReference{Name: "honeycomb-secret"}))
+	assert.NotContains(t, cfg.Service.Pipelines, "logs")
+
+	// a destination without its secret is left out of the node collector, so its logs are sent through the gateway
+	cfg = render(honeycomb("honeycomb", nil))
+	require.Contains(t, cfg.Service.Pipelines, "logs")
+	assert.Equal(t, []string{"otlp/gateway"}, cfg.Service.Pipelines["logs"].Exporters)
+}
+
+// toGenericMap returns the nested map of the rendered config, which is decoded with the type of the map it is in
+func toGenericMap(value interface{}) config.GenericMap {
+	m, _ := value.(config.GenericMap)
+	return m
+}
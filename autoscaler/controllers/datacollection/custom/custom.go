@@ -1,58 +1,132 @@
 package custom
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
-func ShouldApplyCustomDataCollection(dests *odigosv1.DestinationList) bool {
-	for _, dst := range dests.Items {
-		if destRequiresCustomDataCollection(dst) {
-			return true
+var availableNodeDestinations = []NodeDestination{
+	&Honeycomb{},
+}
+
+// NodeDestination is implemented by destination types that collect some of their telemetry on the nodes,
+// instead of receiving it from the gateway.
+// each destination of such type contributes its own receivers, pipelines, containers, env vars and volumes to the node collector,
+// so any number of them can coexist.
+type NodeDestination interface {
+	DestType() common.DestinationType
+
+	// CollectsSignal tells if the signal is collected for the destination on the node.
+	// the node collector does not collect such signals for the gateway on behalf of the destination.
+	CollectsSignal(signal common.ObservabilitySignal) bool
+
+	// Validate tells if the destination can run on the node.
+	// a destination that fails it is left out of the node collector, the other destinations are still applied.
+	Validate(dest *odigosv1.Destination) error
+
+	// ModifyConfig adds the receivers, exporters and pipelines of the destination to the node collector config
+	ModifyConfig(dest *odigosv1.Destination, currentConfig *config.Config) error
+
+	// ModifyConfigMap adds the files the destination needs to the node collector config map
+	ModifyConfigMap(dest *odigosv1.Destination, cm *corev1.ConfigMap) error
+
+	// ModifyDaemonSet adds the containers, env vars and volumes the destination needs to the node collector daemonset
+	ModifyDaemonSet(dest *odigosv1.Destination, ds *v1.DaemonSet) error
+}
+
+func getNodeDestination(destType common.DestinationType) NodeDestination {
+	for _, nodeDest := range availableNodeDestinations {
+		if nodeDest.DestType() == destType {
+			return nodeDest
 		}
 	}
-	return false
+	return nil
+}
+
+// CollectsSignal tells if the signal of the destination is collected for it on the node,
+// rather than by the node collector pipelines that send to the gateway.
+// invalid destinations are left out of the node collector, so their signals are sent through the gateway.
+func CollectsSignal(dest *odigosv1.Destination, signal common.ObservabilitySignal) bool {
+	nodeDest := getNodeDestination(dest.Spec.Type)
+	return nodeDest != nil && nodeDest.CollectsSignal(signal) && nodeDest.Validate(dest) == nil
 }
 
-func destRequiresCustomDataCollection(dest odigosv1.Destination) bool {
-	if DestRequiresCustom(dest.Spec.Type) {
-		for _, s := range dest.Spec.Signals {
-			if s != common.TracesObservabilitySignal {
-				return true
-			}
+// forEachNodeDestination calls f on the valid destinations that collect any of their enabled signals on the node, in the order of the list.
+// invalid destinations are skipped, they are reported by ValidateDestinations.
+func forEachNodeDestination(dests *odigosv1.DestinationList, f func(dest *odigosv1.Destination, nodeDest NodeDestination) error) error {
+	for i := range dests.Items {
+		dest := &dests.Items[i]
+		nodeDest := getNodeDestination(dest.Spec.Type)
+		if nodeDest == nil || !collectsAnySignal(dest, nodeDest) || nodeDest.Validate(dest) != nil {
+			continue
+		}
+		if err := f(dest, nodeDest); err != nil {
+			return fmt.Errorf("failed to apply destination %s(%s) to the node collector: %w", dest.Name, dest.Spec.Type, err)
 		}
 	}
-
-	return false
+	return nil
 }
 
-func DestRequiresCustom(destType common.DestinationType) bool {
-	switch destType {
-	case common.HoneycombDestinationType:
-		return true
-	default:
-		return false
+// ValidateDestinations returns the validation error of each destination that collects signals on the node, by destination name.
+// the error is nil for the destinations applied to the node collector.
+func ValidateDestinations(dests *odigosv1.DestinationList) map[string]error {
+	status := map[string]error{}
+	for i := range dests.Items {
+		dest := &dests.Items[i]
+		nodeDest := getNodeDestination(dest.Spec.Type)
+		if nodeDest == nil || !collectsAnySignal(dest, nodeDest) {
+			continue
+		}
+		status[dest.Name] = nodeDest.Validate(dest)
 	}
+	return status
 }
 
-func AddCustomConfigMap(dests *odigosv1.DestinationList, cm *corev1.ConfigMap) {
-	for _, dst := range dests.Items {
-		if dst.Spec.Type == common.HoneycombDestinationType {
-			addHoneycombConfig(cm, dst)
-			return
+func collectsAnySignal(dest *odigosv1.Destination, nodeDest NodeDestination) bool {
+	for _, signal := range dest.Spec.Signals {
+		if nodeDest.CollectsSignal(signal) {
+			return true
 		}
 	}
+	return false
+}
+
+func ModifyConfig(dests *odigosv1.DestinationList, currentConfig *config.Config) error {
+	return forEachNodeDestination(dests, func(dest *odigosv1.Destination, nodeDest NodeDestination) error {
+		return nodeDest.ModifyConfig(dest, currentConfig)
+	})
+}
+
+func ModifyConfigMap(dests *odigosv1.DestinationList, cm *corev1.ConfigMap) error {
+	return forEachNodeDestination(dests, func(dest *odigosv1.Destination, nodeDest NodeDestination) error {
+		return nodeDest.ModifyConfigMap(dest, cm)
+	})
+}
+
+func ModifyDaemonSet(dests *odigosv1.DestinationList, ds *v1.DaemonSet) error {
+	return forEachNodeDestination(dests, func(dest *odigosv1.Destination, nodeDest NodeDestination) error {
+		return nodeDest.ModifyDaemonSet(dest, ds)
+	})
+}
+
+// resourceName returns a name for a container, volume or config map key of the destination,
+// unique per destination and valid in k8s, as destination names can contain dots.
+func resourceName(prefix string, dest *odigosv1.Destination) string {
+	return fmt.Sprintf("%s-%x", prefix, sha256.Sum256([]byte(dest.Name)))[:len(prefix)+9]
 }
 
-func ApplyCustomChangesToDaemonSet(ds *v1.DaemonSet, dests *odigosv1.DestinationList) {
-	secretName := ""
-	for _, dst := range dests.Items {
-		if dst.Spec.Type == common.HoneycombDestinationType {
-			secretName = dst.Spec.SecretRef.Name
-			break
+// configMapName returns the name of the node collector config map, mounted in the daemonset
+func configMapName(ds *v1.DaemonSet) (string, error) {
+	for _, vol := range ds.Spec.Template.Spec.Volumes {
+		if vol.Name == "conf" && vol.ConfigMap != nil {
+			return vol.ConfigMap.Name, nil
 		}
 	}
-	addHoneycombToDaemonSet(ds, secretName)
+	return "", fmt.Errorf("node collector config volume not found")
 }
@@ -0,0 +1,123 @@
+package custom
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newHoneycombDestination(name string, endpoint string, signals ...common.ObservabilitySignal) odigosv1.Destination {
+	return odigosv1.Destination{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "odigos-system"},
+		Spec: odigosv1.DestinationSpec{
+			Type:      common.HoneycombDestinationType,
+			Data:      map[string]string{honeycombEndpoint: endpoint},
+			Signals:   signals,
+			SecretRef: &corev1.LocalObjectReference{Name: name + "-secret"},
+		},
+	}
+}
+
+func newTestDestinations() *odigosv1.DestinationList {
+	return &odigosv1.DestinationList{
+		Items: []odigosv1.Destination{
+			newHoneycombDestination("honeycomb", "api.honeycomb.io", common.LogsObservabilitySignal, common.MetricsObservabilitySignal),
+			newHoneycombDestination("honeycomb.eu", "api.eu1.honeycomb.io", common.LogsObservabilitySignal),
+			// traces are sent through the gateway, nothing runs on the node for this destination
+			newHoneycombDestination("honeycomb-traces", "api.honeycomb.io", common.TracesObservabilitySignal),
+		},
+	}
+}
+
+func newTestDaemonSet() *v1.DaemonSet {
+	return &v1.DaemonSet{
+		Spec: v1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "data-collection"}},
+					Volumes: []corev1.Volume{
+						{
+							Name: "conf",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "odigos-data-collection"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestModifyConfigMapHoneycomb(t *testing.T) {
+	cm := &corev1.ConfigMap{Data: map[string]string{"conf": "collector config"}}
+	require.NoError(t, ModifyConfigMap(newTestDestinations(), cm))
+
+	usKey := resourceName("honeycomb", &newTestDestinations().Items[0])
+	euKey := resourceName("honeycomb", &newTestDestinations().Items[1])
+	assert.NotEqual(t, usKey, euKey)
+	assert.Len(t, cm.Data, 3)
+	assert.Equal(t, "collector config", cm.Data["conf"])
+	assert.Contains(t, cm.Data[usKey], "apiHost: api.honeycomb.io\n")
+	assert.Contains(t, cm.Data[euKey], "apiHost: api.eu1.honeycomb.io\n")
+}
+
+func TestModifyDaemonSetHoneycomb(t *testing.T) {
+	dests := newTestDestinations()
+	ds := newTestDaemonSet()
+	require.NoError(t, ModifyDaemonSet(dests, ds))
+
+	podSpec := ds.Spec.Template.Spec
+	require.Len(t, podSpec.Containers, 3)
+	require.Len(t, podSpec.Volumes, 3)
+	assert.Equal(t, "data-collection", podSpec.Containers[0].Name)
+	assert.Equal(t, "conf", podSpec.Volumes[0].Name)
+
+	names := map[string]bool{}
+	for i, dest := range dests.Items[:2] {
+		name := resourceName("honeycomb", &dest)
+		assert.LessOrEqual(t, len(name), 63)
+		names[name] = true
+
+		container := podSpec.Containers[i+1]
+		assert.Equal(t, name, container.Name)
+		assert.Equal(t, dest.Name+"-secret", container.Env[2].ValueFrom.SecretKeyRef.Name)
+		assert.Equal(t, name, container.VolumeMounts[2].Name)
+
+		volume := podSpec.Volumes[i+1]
+		assert.Equal(t, name, volume.Name)
+		require.NotNil(t, volume.ConfigMap)
+		assert.Equal(t, "odigos-data-collection", volume.ConfigMap.Name)
+		assert.Equal(t, []corev1.KeyToPath{{Key: name, Path: "config.yaml"}}, volume.ConfigMap.Items)
+	}
+	assert.Len(t, names, 2)
+}
+
+func TestHoneycombMissingSecretSkipsDestination(t *testing.T) {
+	dests := newTestDestinations()
+	dests.Items[1].Spec.SecretRef = nil
+
+	// the destination without a secret is left out, the other one still runs on the node
+	ds := newTestDaemonSet()
+	require.NoError(t, ModifyDaemonSet(dests, ds))
+	require.Len(t, ds.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, resourceName("honeycomb", &dests.Items[0]), ds.Spec.Template.Spec.Containers[1].Name)
+
+	cm := &corev1.ConfigMap{Data: map[string]string{"conf": "collector config"}}
+	require.NoError(t, ModifyConfigMap(dests, cm))
+	assert.Len(t, cm.Data, 2)
+	assert.NotContains(t, cm.Data, resourceName("honeycomb", &dests.Items[1]))
+
+	status := ValidateDestinations(dests)
+	assert.Len(t, status, 2)
+	assert.NoError(t, status["honeycomb"])
+	assert.ErrorContains(t, status["honeycomb.eu"], "missing secret")
+}
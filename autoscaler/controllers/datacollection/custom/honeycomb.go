@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
 	v1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -11,11 +13,33 @@ import (
 const (
 	honeycombDataCollectionImage = "honeycombio/honeycomb-kubernetes-agent:2.6.0"
 	honeycombConfigMountPath     = "/etc/honeycomb"
-	honeycombConfigKey           = "honeycomb-conf"
 	honeycombEndpoint            = "HONEYCOMB_ENDPOINT"
 )
 
-func addHoneycombConfig(cm *corev1.ConfigMap, dst odigosv1.Destination) {
+// Honeycomb runs the honeycomb kubernetes agent on each node, which collects the logs and metrics of the node.
+// traces are sent to honeycomb through the gateway.
+type Honeycomb struct{}
+
+func (h *Honeycomb) DestType() common.DestinationType {
+	return common.HoneycombDestinationType
+}
+
+func (h *Honeycomb) CollectsSignal(signal common.ObservabilitySignal) bool {
+	return signal != common.TracesObservabilitySignal
+}
+
+func (h *Honeycomb) Validate(dest *odigosv1.Destination) error {
+	if dest.Spec.SecretRef == nil {
+		return fmt.Errorf("missing secret with the honeycomb api key")
+	}
+	return nil
+}
+
+func (h *Honeycomb) ModifyConfig(dest *odigosv1.Destination, currentConfig *config.Config) error {
+	return nil
+}
+
+func (h *Honeycomb) ModifyConfigMap(dest *odigosv1.Destination, cm *corev1.ConfigMap) error {
 	template := `    apiHost: %s
     watchers:
       - dataset: kubernetes-logs
@@ -47,12 +71,37 @@ func addHoneycombConfig(cm *corev1.ConfigMap, dst odigosv1.Destination) {
       metricGroups:
       - node
       - pod`
-	cm.Data[honeycombConfigKey] = fmt.Sprintf(template, dst.Spec.Data[honeycombEndpoint])
+	cm.Data[resourceName("honeycomb", dest)] = fmt.Sprintf(template, dest.Spec.Data[honeycombEndpoint])
+	return nil
 }
 
-func addHoneycombToDaemonSet(ds *v1.DaemonSet, secretName string) {
+func (h *Honeycomb) ModifyDaemonSet(dest *odigosv1.Destination, ds *v1.DaemonSet) error {
+	cmName, err := configMapName(ds)
+	if err != nil {
+		return err
+	}
+
+	// each agent mounts its own config, as they all read it from the same path
+	name := resourceName("honeycomb", dest)
+	ds.Spec.Template.Spec.Volumes = append(ds.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: cmName,
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  name,
+						Path: "config.yaml",
+					},
+				},
+			},
+		},
+	})
+
 	ds.Spec.Template.Spec.Containers = append(ds.Spec.Template.Spec.Containers, corev1.Container{
-		Name:  "honeycomb-collector",
+		Name:  name,
 		Image: honeycombDataCollectionImage,
 		Env: []corev1.EnvVar{
 			{
@@ -76,7 +125,7 @@ func addHoneycombToDaemonSet(ds *v1.DaemonSet, secretName string) {
 				ValueFrom: &corev1.EnvVarSource{
 					SecretKeyRef: &corev1.SecretKeySelector{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: secretName,
+							Name: dest.Spec.SecretRef.Name,
 						},
 						Key: "HONEYCOMB_API_KEY",
 					},
@@ -95,19 +144,12 @@ func addHoneycombToDaemonSet(ds *v1.DaemonSet, secretName string) {
 				ReadOnly:  true,
 			},
 			{
-				Name:      "conf",
+				Name:      name,
 				MountPath: honeycombConfigMountPath,
 				ReadOnly:  false,
 			},
 		},
 	})
 
-	for i, vol := range ds.Spec.Template.Spec.Volumes {
-		if vol.Name == "conf" {
-			ds.Spec.Template.Spec.Volumes[i].ConfigMap.Items = append(ds.Spec.Template.Spec.Volumes[i].ConfigMap.Items, corev1.KeyToPath{
-				Key:  honeycombConfigKey,
-				Path: "config.yaml",
-			})
-		}
-	}
+	return nil
 }
@@ -14,6 +14,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -49,7 +50,8 @@ func getOdigletDaemonsetPodSpec(ctx context.Context, c client.Client, namespace
 }
 
 func syncDaemonSet(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.DestinationList, datacollection *odigosv1.CollectorsGroup, configData string, ctx context.Context,
-	c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string) (*appsv1.DaemonSet, error) {
+	c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, collectorTLS *common.CollectorTLS,
+	resourceConfig *resourceConfigurations) (*appsv1.DaemonSet, error) {
 	logger := log.FromContext(ctx)
 
 	odigletDaemonsetPodSpec, err := getOdigletDaemonsetPodSpec(ctx, c, datacollection.Namespace)
@@ -58,14 +60,15 @@ func syncDaemonSet(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.D
 		return nil, err
 	}
 
-	desiredDs, err := getDesiredDaemonSet(datacollection, configData, scheme, imagePullSecrets, odigosVersion, odigletDaemonsetPodSpec)
+	desiredDs, err := getDesiredDaemonSet(datacollection, configData, scheme, imagePullSecrets, odigosVersion, odigletDaemonsetPodSpec, collectorTLS, resourceConfig)
 	if err != nil {
 		logger.Error(err, "Failed to get desired DaemonSet")
 		return nil, err
 	}
 
-	if custom.ShouldApplyCustomDataCollection(dests) {
-		custom.ApplyCustomChangesToDaemonSet(desiredDs, dests)
+	if err := custom.ModifyDaemonSet(dests, desiredDs); err != nil {
+		logger.Error(err, "Failed to apply destinations to the DaemonSet")
+		return nil, err
 	}
 
 	existing := &appsv1.DaemonSet{}
@@ -95,7 +98,7 @@ func syncDaemonSet(apps *odigosv1.InstrumentedApplicationList, dests *odigosv1.D
 
 func getDesiredDaemonSet(datacollection *odigosv1.CollectorsGroup, configData string,
 	scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string,
-	odigletDaemonsetPodSpec *corev1.PodSpec,
+	odigletDaemonsetPodSpec *corev1.PodSpec, collectorTLS *common.CollectorTLS, resourceConfig *resourceConfigurations,
 ) (*appsv1.DaemonSet, error) {
 	// TODO(edenfed): add log volumes only if needed according to apps or dests
 
@@ -216,6 +219,7 @@ func getDesiredDaemonSet(datacollection *odigosv1.CollectorsGroup, configData st
 									},
 								},
 							},
+							Resources: getResourceRequirements(resourceConfig),
 							LivenessProbe: &corev1.Probe{
 								ProbeHandler: corev1.ProbeHandler{
 									HTTPGet: &corev1.HTTPGetAction{
@@ -245,6 +249,18 @@ func getDesiredDaemonSet(datacollection *odigosv1.CollectorsGroup, configData st
 		},
 	}
 
+	if resourceConfig.gomemlimitMiB > 0 {
+		container := &desiredDs.Spec.Template.Spec.Containers[0]
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "GOMEMLIMIT",
+			Value: fmt.Sprintf("%dMiB", resourceConfig.gomemlimitMiB),
+		})
+	}
+
+	if collectorTLS != nil {
+		common.MountCollectorTLS(&desiredDs.Spec.Template, collectorTLS, common.CollectorTLSCAKey)
+	}
+
 	if len(imagePullSecrets) > 0 {
 		desiredDs.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{}
 		for _, secret := range imagePullSecrets {
@@ -286,6 +302,27 @@ func patchDaemonSet(existing *appsv1.DaemonSet, desired *appsv1.DaemonSet, ctx c
 	return updated, nil
 }
 
+// getResourceRequirements returns the configured requests and limits of the node collector, none are set by default
+func getResourceRequirements(resourceConfig *resourceConfigurations) corev1.ResourceRequirements {
+	requirements := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+	if resourceConfig.memoryRequestMiB > 0 {
+		requirements.Requests[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", resourceConfig.memoryRequestMiB))
+	}
+	if resourceConfig.cpuRequestm > 0 {
+		requirements.Requests[corev1.ResourceCPU] = resource.MustParse(fmt.Sprintf("%dm", resourceConfig.cpuRequestm))
+	}
+	if resourceConfig.memoryLimitMiB > 0 {
+		requirements.Limits[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", resourceConfig.memoryLimitMiB))
+	}
+	if resourceConfig.cpuLimitm > 0 {
+		requirements.Limits[corev1.ResourceCPU] = resource.MustParse(fmt.Sprintf("%dm", resourceConfig.cpuLimitm))
+	}
+	return requirements
+}
+
 func boolPtr(b bool) *bool {
 	return &b
 }
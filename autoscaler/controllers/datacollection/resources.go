@@ -0,0 +1,101 @@
+package datacollection
+
+import odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+
+const (
+	// if only the memory request is configured, the collector is assumed to be able to use twice the request,
+	// to allow for spikes on busy nodes while keeping the scheduling footprint small on quiet ones.
+	defaultMemoryLimitToRequestRatio = 2
+
+	// this configures the processor limit_mib, which is the hard limit in MiB, afterwhich garbage collection will be forced.
+	// as recommended by the processor docs, if not set, this is set to 50MiB less than the memory limit of the collector
+	defaultMemoryLimiterLimitDiffMib = 50
+
+	// the soft limit will be set to 80% of the hard limit.
+	// this value is used to derive the "spike_limit_mib" parameter in the processor configuration if a value is not set
+	defaultMemoryLimiterSpikePercentage = 20.0
+
+	// the percentage out of the memory limiter hard limit, at which go runtime will start garbage collection.
+	// it is used to calculate the GOMEMLIMIT environment variable value.
+	defaultGoMemLimitPercentage = 80.0
+)
+
+// resourceConfigurations of the node collector, the requests and limits are 0 when not configured.
+// the memory limiter and GOMEMLIMIT are 0 as well when no memory is configured, and are then left out.
+type resourceConfigurations struct {
+	memoryRequestMiB           int
+	memoryLimitMiB             int
+	cpuRequestm                int
+	cpuLimitm                  int
+	memoryLimiterLimitMiB      int
+	memoryLimiterSpikeLimitMiB int
+	gomemlimitMiB              int
+}
+
+func getResourceConfigurations(odigosConfig *odigosv1.OdigosConfiguration) *resourceConfigurations {
+	nodeConfig := odigosConfig.Spec.CollectorNode
+	if nodeConfig == nil {
+		nodeConfig = &odigosv1.CollectorNodeConfiguration{}
+	}
+
+	memoryRequestMiB := nodeConfig.RequestMemoryMiB
+	memoryLimitMiB := nodeConfig.LimitMemoryMiB
+	// kubernetes rejects a limit lower than the request
+	if memoryLimitMiB > 0 && memoryLimitMiB < memoryRequestMiB {
+		memoryLimitMiB = memoryRequestMiB
+	}
+
+	cpuRequestm := nodeConfig.RequestCPUm
+	cpuLimitm := nodeConfig.LimitCPUm
+	if cpuLimitm > 0 && cpuLimitm < cpuRequestm {
+		cpuLimitm = cpuRequestm
+	}
+
+	// the memory the collector can use, which the memory limiter keeps it under. it is 0 when no memory is configured
+	memoryBudgetMiB := 0
+	if memoryLimitMiB > 0 {
+		memoryBudgetMiB = memoryLimitMiB
+	} else if memoryRequestMiB > 0 {
+		memoryBudgetMiB = memoryRequestMiB * defaultMemoryLimitToRequestRatio
+	}
+
+	// no memory limiter is added unless the memory of the collector or the limiter itself is configured,
+	// so the collector is never throttled by a guessed budget on nodes where it used more memory before.
+	memoryLimiterLimitMiB := nodeConfig.MemoryLimiterLimitMiB
+	if memoryBudgetMiB > 0 {
+		// the memory limiter hard limit is set as 50 MiB less than the memory budget, so the collector refuses data before it is OOM killed
+		if memoryLimiterLimitMiB <= 0 {
+			memoryLimiterLimitMiB = memoryBudgetMiB - defaultMemoryLimiterLimitDiffMib
+		}
+		// the processor rejects a hard limit that is not positive, and one above the memory budget is never reached
+		if memoryLimiterLimitMiB <= 0 || memoryLimiterLimitMiB > memoryBudgetMiB {
+			memoryLimiterLimitMiB = memoryBudgetMiB * (100 - defaultMemoryLimiterSpikePercentage) / 100.0
+		}
+		if memoryLimiterLimitMiB < 1 {
+			memoryLimiterLimitMiB = 1
+		}
+	}
+
+	// a spike limit of 0 makes the processor fall back to its own default of 20% of the hard limit
+	memoryLimiterSpikeLimitMiB := memoryLimiterLimitMiB * defaultMemoryLimiterSpikePercentage / 100.0
+	// the processor rejects a spike limit that is not lower than the hard limit
+	if nodeConfig.MemoryLimiterSpikeLimitMiB > 0 && nodeConfig.MemoryLimiterSpikeLimitMiB < memoryLimiterLimitMiB {
+		memoryLimiterSpikeLimitMiB = nodeConfig.MemoryLimiterSpikeLimitMiB
+	}
+
+	// GOMEMLIMIT is not set when there is no memory limiter, unless it is configured
+	gomemlimitMiB := int(memoryLimiterLimitMiB * defaultGoMemLimitPercentage / 100.0)
+	if nodeConfig.GoMemLimitMib > 0 {
+		gomemlimitMiB = nodeConfig.GoMemLimitMib
+	}
+
+	return &resourceConfigurations{
+		memoryRequestMiB:           memoryRequestMiB,
+		memoryLimitMiB:             memoryLimitMiB,
+		cpuRequestm:                cpuRequestm,
+		cpuLimitm:                  cpuLimitm,
+		memoryLimiterLimitMiB:      memoryLimiterLimitMiB,
+		memoryLimiterSpikeLimitMiB: memoryLimiterSpikeLimitMiB,
+		gomemlimitMiB:              gomemlimitMiB,
+	}
+}
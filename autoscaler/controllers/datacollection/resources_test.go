@@ -0,0 +1,194 @@
+package datacollection
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetResourceConfigurations(t *testing.T) {
+	tests := []struct {
+		name       string
+		nodeConfig *odigosv1.CollectorNodeConfiguration
+		expected   resourceConfigurations
+	}{
+		{
+			name:     "defaults",
+			expected: resourceConfigurations{},
+		},
+		{
+			name: "memory request only",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				RequestMemoryMiB: 400,
+			},
+			expected: resourceConfigurations{
+				memoryRequestMiB:           400,
+				memoryLimiterLimitMiB:      750,
+				memoryLimiterSpikeLimitMiB: 150,
+				gomemlimitMiB:              600,
+			},
+		},
+		{
+			name: "memory limit lower than the request",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				RequestMemoryMiB: 300,
+				LimitMemoryMiB:   200,
+			},
+			expected: resourceConfigurations{
+				memoryRequestMiB:           300,
+				memoryLimitMiB:             300,
+				memoryLimiterLimitMiB:      250,
+				memoryLimiterSpikeLimitMiB: 50,
+				gomemlimitMiB:              200,
+			},
+		},
+		{
+			name: "cpu limit lower than the request",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				RequestCPUm: 500,
+				LimitCPUm:   100,
+			},
+			expected: resourceConfigurations{
+				cpuRequestm: 500,
+				cpuLimitm:   500,
+			},
+		},
+		{
+			name: "memory limit too small to leave 50MiB",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				RequestMemoryMiB: 40,
+				LimitMemoryMiB:   50,
+			},
+			expected: resourceConfigurations{
+				memoryRequestMiB:           40,
+				memoryLimitMiB:             50,
+				memoryLimiterLimitMiB:      40,
+				memoryLimiterSpikeLimitMiB: 8,
+				gomemlimitMiB:              32,
+			},
+		},
+		{
+			name: "memory limiter limit above the memory limit",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				LimitMemoryMiB:        500,
+				MemoryLimiterLimitMiB: 1000,
+			},
+			expected: resourceConfigurations{
+				memoryLimitMiB:             500,
+				memoryLimiterLimitMiB:      400,
+				memoryLimiterSpikeLimitMiB: 80,
+				gomemlimitMiB:              320,
+			},
+		},
+		{
+			name: "spike limit not lower than the memory limiter limit",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				MemoryLimiterLimitMiB:      300,
+				MemoryLimiterSpikeLimitMiB: 300,
+			},
+			expected: resourceConfigurations{
+				memoryLimiterLimitMiB:      300,
+				memoryLimiterSpikeLimitMiB: 60,
+				gomemlimitMiB:              240,
+			},
+		},
+		{
+			name: "memory limiter without memory limit",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				MemoryLimiterLimitMiB: 1000,
+			},
+			expected: resourceConfigurations{
+				memoryLimiterLimitMiB:      1000,
+				memoryLimiterSpikeLimitMiB: 200,
+				gomemlimitMiB:              800,
+			},
+		},
+		{
+			name: "gomemlimit only",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				GoMemLimitMib: 300,
+			},
+			expected: resourceConfigurations{
+				gomemlimitMiB: 300,
+			},
+		},
+		{
+			name: "configured memory limiter",
+			nodeConfig: &odigosv1.CollectorNodeConfiguration{
+				MemoryLimiterLimitMiB:      300,
+				MemoryLimiterSpikeLimitMiB: 100,
+				GoMemLimitMib:              200,
+			},
+			expected: resourceConfigurations{
+				memoryLimiterLimitMiB:      300,
+				memoryLimiterSpikeLimitMiB: 100,
+				gomemlimitMiB:              200,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			odigosConfig := &odigosv1.OdigosConfiguration{
+				Spec: odigosv1.OdigosConfigurationSpec{CollectorNode: tt.nodeConfig},
+			}
+			assert.Equal(t, &tt.expected, getResourceConfigurations(odigosConfig))
+		})
+	}
+}
+
+func TestGetResourceRequirements(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   resourceConfigurations
+		expected corev1.ResourceRequirements
+	}{
+		{
+			name:   "not configured",
+			config: resourceConfigurations{},
+			expected: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{},
+				Limits:   corev1.ResourceList{},
+			},
+		},
+		{
+			name: "memory request and cpu limit",
+			config: resourceConfigurations{
+				memoryRequestMiB: 300,
+				cpuLimitm:        200,
+			},
+			expected: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("300Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+			},
+		},
+		{
+			name: "all configured",
+			config: resourceConfigurations{
+				memoryRequestMiB: 300,
+				memoryLimitMiB:   600,
+				cpuRequestm:      100,
+				cpuLimitm:        200,
+			},
+			expected: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("300Mi"),
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("600Mi"),
+					corev1.ResourceCPU:    resource.MustParse("200m"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getResourceRequirements(&tt.config))
+		})
+	}
+}
@@ -4,13 +4,22 @@ import (
 	"context"
 
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/autoscaler/controllers/datacollection/custom"
+	"github.com/odigos-io/odigos/common/consts"
+	odgiosK8s "github.com/odigos-io/odigos/k8sutils/pkg/conditions"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// the condition of the destinations that run on the node, set apart from the gateway condition of the destination
+const nodeCollectorConfiguredType = "NodeCollectorConfigured"
+
 func Sync(ctx context.Context, c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string) error {
 	logger := log.FromContext(ctx)
 	var collectorGroups odigosv1.CollectorsGroupList
@@ -50,27 +59,44 @@ func Sync(ctx context.Context, c client.Client, scheme *runtime.Scheme, imagePul
 		return err
 	}
 
-	return syncDataCollection(&instApps, &dests, &processors, dataCollectionCollectorGroup, ctx, c, scheme, imagePullSecrets, odigosVersion)
+	var odigosConfig odigosv1.OdigosConfiguration
+	if err := c.Get(ctx, types.NamespacedName{Namespace: env.GetCurrentNamespace(), Name: consts.OdigosConfigurationName}, &odigosConfig); err != nil {
+		logger.Error(err, "Failed to get odigos config")
+		return err
+	}
+
+	// the certificate is issued when the gateway is synced
+	collectorTLS, err := commonconf.GetCollectorTLS(ctx, c, dataCollectionCollectorGroup.Namespace, &odigosConfig)
+	if err != nil {
+		logger.Error(err, "Failed to get collectors tls certificate")
+		return err
+	}
+
+	resourceConfig := getResourceConfigurations(&odigosConfig)
+
+	return syncDataCollection(&instApps, &dests, &processors, dataCollectionCollectorGroup, ctx, c, scheme, imagePullSecrets, odigosVersion, collectorTLS, resourceConfig)
 }
 
 func syncDataCollection(instApps *odigosv1.InstrumentedApplicationList, dests *odigosv1.DestinationList, processors *odigosv1.ProcessorList,
 	dataCollection *odigosv1.CollectorsGroup, ctx context.Context, c client.Client,
-	scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string) error {
+	scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, collectorTLS *commonconf.CollectorTLS, resourceConfig *resourceConfigurations) error {
 	logger := log.FromContext(ctx)
 	logger.V(0).Info("Syncing data collection")
 
-	configData, err := syncConfigMap(instApps, dests, processors, dataCollection, ctx, c, scheme)
+	configData, err := syncConfigMap(instApps, dests, processors, dataCollection, ctx, c, scheme, collectorTLS, resourceConfig)
 	if err != nil {
 		logger.Error(err, "Failed to sync config map")
 		return err
 	}
 
-	ds, err := syncDaemonSet(instApps, dests, dataCollection, configData, ctx, c, scheme, imagePullSecrets, odigosVersion)
+	ds, err := syncDaemonSet(instApps, dests, dataCollection, configData, ctx, c, scheme, imagePullSecrets, odigosVersion, collectorTLS, resourceConfig)
 	if err != nil {
 		logger.Error(err, "Failed to sync daemon set")
 		return err
 	}
 
+	updateNodeDestinationsStatus(ctx, c, dests)
+
 	isNowReady := calcDataCollectionReadyStatus(ds)
 	if !dataCollection.Status.Ready && isNowReady {
 		if err := c.Status().Patch(ctx, dataCollection, client.RawPatch(
@@ -89,3 +115,29 @@ func syncDataCollection(instApps *odigosv1.InstrumentedApplicationList, dests *o
 func calcDataCollectionReadyStatus(ds *appsv1.DaemonSet) bool {
 	return ds.Status.DesiredNumberScheduled > 0 && float64(ds.Status.NumberReady) >= float64(ds.Status.DesiredNumberScheduled)/float64(2)
 }
+
+// updateNodeDestinationsStatus reports on each destination that runs on the node if it was applied to the node collector.
+// an invalid destination is left out of the node collector without failing the others.
+func updateNodeDestinationsStatus(ctx context.Context, c client.Client, dests *odigosv1.DestinationList) {
+	logger := log.FromContext(ctx)
+	status := custom.ValidateDestinations(dests)
+	for i := range dests.Items {
+		dest := &dests.Items[i]
+		destErr, found := status[dest.Name]
+		if !found {
+			continue
+		}
+		if destErr != nil {
+			logger.Error(destErr, "Destination is left out of the node collector", "destination", dest.Name)
+			err := odgiosK8s.UpdateStatusConditions(ctx, c, dest, &dest.Status.Conditions, metav1.ConditionFalse, nodeCollectorConfiguredType, "ErrConfigNodeDestination", destErr.Error())
+			if err != nil {
+				logger.Error(err, "Failed to update destination error status conditions")
+			}
+		} else {
+			err := odgiosK8s.UpdateStatusConditions(ctx, c, dest, &dest.Status.Conditions, metav1.ConditionTrue, nodeCollectorConfiguredType, "AppliedToNodeCollector", "destination successfully applied to the node collector")
+			if err != nil {
+				logger.Error(err, "Failed to update destination success status conditions")
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package datacollection
+
+import (
+	"context"
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateNodeDestinationsStatus(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, odigosv1.AddToScheme(scheme))
+
+	newHoneycomb := func(name string, secretRef *corev1.LocalObjectReference) *odigosv1.Destination {
+		return &odigosv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "odigos-system"},
+			Spec: odigosv1.DestinationSpec{
+				Type:      common.HoneycombDestinationType,
+				Data:      map[string]string{"HONEYCOMB_ENDPOINT": "api.honeycomb.io"},
+				Signals:   []common.ObservabilitySignal{common.LogsObservabilitySignal},
+				SecretRef: secretRef,
+			},
+		}
+	}
+	valid := newHoneycomb("honeycomb", &corev1.LocalObjectReference{Name: "honeycomb-secret"})
+	missingSecret := newHoneycomb("honeycomb-no-secret", nil)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(valid, missingSecret).
+		WithStatusSubresource(&odigosv1.Destination{}).Build()
+
+	dests := &odigosv1.DestinationList{Items: []odigosv1.Destination{*valid, *missingSecret}}
+	updateNodeDestinationsStatus(ctx, c, dests)
+
+	var dest odigosv1.Destination
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(valid), &dest))
+	assert.True(t, meta.IsStatusConditionTrue(dest.Status.Conditions, nodeCollectorConfiguredType))
+
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(missingSecret), &dest))
+	cond := meta.FindStatusCondition(dest.Status.Conditions, nodeCollectorConfiguredType)
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Contains(t, cond.Message, "missing secret")
+}
@@ -19,11 +19,17 @@ package controllers
 import (
 	"context"
 
+	"github.com/odigos-io/odigos/autoscaler/controllers/common"
 	"github.com/odigos-io/odigos/autoscaler/controllers/gateway"
+	"github.com/odigos-io/odigos/common/config"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 )
@@ -63,5 +69,27 @@ func (r *DestinationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 func (r *DestinationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1.Destination{}).
+		// the tls secrets of the destinations are read into the gateway config
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.destinationsOfTLSSecret)).
 		Complete(r)
 }
+
+// destinationsOfTLSSecret returns the destinations referencing the secret for tls
+func (r *DestinationReconciler) destinationsOfTLSSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	var dests v1.DestinationList
+	if err := r.List(ctx, &dests, client.InNamespace(secret.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list destinations")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	configurers := common.ToExporterConfigurerArray(&dests)
+	for i, dest := range dests.Items {
+		for _, secretName := range config.TLSSecretNames(configurers[i : i+1]) {
+			if secretName == secret.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: dest.Namespace, Name: dest.Name}})
+			}
+		}
+	}
+	return requests
+}
@@ -2,10 +2,12 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/autoscaler/controllers/validation"
 	"github.com/odigos-io/odigos/common/config"
 	odgiosK8s "github.com/odigos-io/odigos/k8sutils/pkg/conditions"
 	v1 "k8s.io/api/core/v1"
@@ -22,7 +24,28 @@ const (
 	destinationConfiguredType = "DestinationConfigured"
 )
 
-func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.ProcessorList, gateway *odigosv1.CollectorsGroup, ctx context.Context, c client.Client, scheme *runtime.Scheme, memConfig *memoryConfigurations) (string, error) {
+// getTLSSecretKeys returns the keys of the tls secrets referenced by the destinations, by secret name.
+// secrets that do not exist are left out, and reported on the destinations that reference them
+func getTLSSecretKeys(ctx context.Context, c client.Client, namespace string, dests *odigosv1.DestinationList) (map[string]map[string]bool, error) {
+	secretKeys := map[string]map[string]bool{}
+	for _, secretName := range config.TLSSecretNames(common.ToExporterConfigurerArray(dests)) {
+		var secret v1.Secret
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		keys := map[string]bool{}
+		for key := range secret.Data {
+			keys[key] = true
+		}
+		secretKeys[secretName] = keys
+	}
+	return secretKeys, nil
+}
+
+func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.ProcessorList, gateway *odigosv1.CollectorsGroup, ctx context.Context, c client.Client, scheme *runtime.Scheme, memConfig *memoryConfigurations, collectorTLS *common.CollectorTLS) (string, error) {
 	logger := log.FromContext(ctx)
 
 	memoryLimiterConfiguration := config.GenericMap{
@@ -33,16 +56,34 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 
 	processors := common.FilterAndSortProcessorsByOrderHint(allProcessors, odigosv1.CollectorsGroupRoleClusterGateway)
 
-	desiredData, err, status := config.Calculate(
-		common.ToExporterConfigurerArray(dests),
+	currentConfig, globalProcessors := config.GetBasicConfig(memoryLimiterConfiguration)
+	if collectorTLS != nil {
+		if err := enableReceiverTLS(currentConfig, collectorTLS); err != nil {
+			logger.Error(err, "Failed to configure tls on the otlp receiver")
+			return "", err
+		}
+	}
+
+	tlsSecretKeys, err := getTLSSecretKeys(ctx, c, gateway.Namespace, dests)
+	if err != nil {
+		logger.Error(err, "Failed to get the tls secrets of the destinations")
+		return "", err
+	}
+
+	desiredData, err, status := config.CalculateWithBase(
+		currentConfig,
+		globalProcessors,
+		common.ToExporterConfigurerArrayWithTLSSecrets(dests, tlsSecretKeys),
 		common.ToProcessorConfigurerArray(processors),
-		memoryLimiterConfiguration,
 	)
 	if err != nil {
 		logger.Error(err, "Failed to calculate config")
 		return "", err
 	}
 
+	// an invalid config would crash the gateway pods, so it is not rolled out and the errors are reported on the resources that caused them
+	validationErr := validation.ValidateConfigWithStatus(desiredData, status)
+
 	for destName, destErr := range status.Destination {
 		if destErr != nil {
 			logger.Error(destErr, "Failed to calculate config for destination", "destination", destName)
@@ -58,7 +99,7 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 	for _, dest := range dests.Items {
 		if destErr, found := status.Destination[dest.ObjectMeta.Name]; found {
 			if destErr != nil {
-				err := odgiosK8s.UpdateStatusConditions(ctx, c, &dest, &dest.Status.Conditions, metav1.ConditionFalse, destinationConfiguredType, "ErrConfigDestination", destErr.Error())
+				err := odgiosK8s.UpdateStatusConditions(ctx, c, &dest, &dest.Status.Conditions, metav1.ConditionFalse, destinationConfiguredType, validation.ErrorReason(destErr, "ErrConfigDestination"), destErr.Error())
 				if err != nil {
 					logger.Error(err, "Failed to update destination error status conditions")
 				}
@@ -71,6 +112,8 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 		}
 	}
 
+	common.UpdateProcessorsStatus(ctx, c, allProcessors, odigosv1.CollectorsGroupRoleClusterGateway, desiredData, status.Processor, validationErr)
+
 	desired := &v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      gateway.Name,
@@ -89,6 +132,10 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 	existing := &v1.ConfigMap{}
 	if err := c.Get(ctx, client.ObjectKey{Namespace: gateway.Namespace, Name: kubeObjectName}, existing); err != nil {
 		if apierrors.IsNotFound(err) {
+			if validationErr != nil {
+				logger.Error(validationErr, "Gateway config is invalid, not creating gateway config map")
+				return "", validationErr
+			}
 			logger.V(0).Info("Creating gateway config map")
 			_, err := createConfigMap(desired, ctx, c)
 			if err != nil {
@@ -102,6 +149,11 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 		}
 	}
 
+	if validationErr != nil {
+		logger.Error(validationErr, "Gateway config is invalid, keeping the last valid config")
+		return existing.Data[configKey], nil
+	}
+
 	logger.V(0).Info("Patching gateway config map")
 	_, err = patchConfigMap(existing, desired, ctx, c)
 	if err != nil {
@@ -112,6 +164,31 @@ func syncConfigMap(dests *odigosv1.DestinationList, allProcessors *odigosv1.Proc
 	return desiredData, nil
 }
 
+// enableReceiverTLS serves the otlp receiver with the certificate the node collectors verify
+func enableReceiverTLS(currentConfig *config.Config, collectorTLS *common.CollectorTLS) error {
+	otlpReceiver, ok := currentConfig.Receivers["otlp"].(config.GenericMap)
+	if !ok {
+		return fmt.Errorf("unexpected otlp receiver config")
+	}
+	protocols, ok := otlpReceiver["protocols"].(config.GenericMap)
+	if !ok {
+		return fmt.Errorf("unexpected otlp receiver protocols config")
+	}
+
+	for _, protocol := range []string{"grpc", "http"} {
+		protocolConfig, ok := protocols[protocol].(config.GenericMap)
+		if !ok {
+			protocolConfig = config.GenericMap{}
+		}
+		protocolConfig["tls"] = config.GenericMap{
+			"cert_file": collectorTLS.CertFile(),
+			"key_file":  collectorTLS.KeyFile(),
+		}
+		protocols[protocol] = protocolConfig
+	}
+	return nil
+}
+
 func createConfigMap(desired *v1.ConfigMap, ctx context.Context, c client.Client) (*v1.ConfigMap, error) {
 	if err := c.Create(ctx, desired); err != nil {
 		return nil, err
@@ -0,0 +1,96 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetTLSSecretKeys(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client-certs", Namespace: testNamespace},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(secret).Build()
+
+	newDest := func(name string, secretName string) odigosv1.Destination {
+		return odigosv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+			Spec: odigosv1.DestinationSpec{
+				Type: common.GenericOTLPDestinationType,
+				Data: map[string]string{
+					"OTLP_GRPC_ENDPOINT":      "collector.internal:4317",
+					"TLS_SECRET_NAME":         secretName,
+					"TLS_CLIENT_AUTH_ENABLED": "true",
+				},
+				Signals: []common.ObservabilitySignal{common.TracesObservabilitySignal},
+			},
+		}
+	}
+	dests := &odigosv1.DestinationList{Items: []odigosv1.Destination{
+		newDest("with-secret", "client-certs"),
+		newDest("missing-secret", "missing-certs"),
+	}}
+
+	secretKeys, err := getTLSSecretKeys(context.Background(), c, testNamespace, dests)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]bool{
+		"client-certs": {corev1.TLSCertKey: true, corev1.TLSPrivateKeyKey: true},
+	}, secretKeys)
+
+	// the secret has no ca, so the endpoint is verified with the system roots, and the missing secret fails its destination only
+	_, err, status := config.Calculate(commonconf.ToExporterConfigurerArrayWithTLSSecrets(dests, secretKeys), nil, config.GenericMap{})
+	require.NoError(t, err)
+	assert.NoError(t, status.Destination["with-secret"])
+	assert.ErrorContains(t, status.Destination["missing-secret"], "the secret missing-certs set in TLS_SECRET_NAME does not exist")
+}
+
+func TestSyncConfigMapReceiverTLS(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme(t)
+	gateway := newTestGateway()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).Build()
+	dests := &odigosv1.DestinationList{Items: []odigosv1.Destination{{
+		ObjectMeta: metav1.ObjectMeta{Name: "otlp-dest", Namespace: testNamespace},
+		Spec: odigosv1.DestinationSpec{
+			Type:    "otlphttp",
+			Data:    map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318"},
+			Signals: []common.ObservabilitySignal{common.TracesObservabilitySignal},
+		},
+	}}}
+	memConfig := getMemoryConfigurations(&odigosv1.OdigosConfiguration{})
+	collectorTLS := &commonconf.CollectorTLS{SecretName: "odigos-collector-tls"}
+
+	configData, err := syncConfigMap(dests, &odigosv1.ProcessorList{}, gateway, ctx, c, scheme, memConfig, collectorTLS)
+	require.NoError(t, err)
+
+	var cfg config.Config
+	require.NoError(t, yaml.Unmarshal([]byte(configData), &cfg))
+	otlpReceiver, ok := cfg.Receivers["otlp"].(config.GenericMap)
+	require.True(t, ok)
+	protocols, ok := otlpReceiver["protocols"].(config.GenericMap)
+	require.True(t, ok)
+
+	// both protocols are served with the certificate the node collectors verify
+	for _, protocol := range []string{"grpc", "http"} {
+		protocolConfig, ok := protocols[protocol].(config.GenericMap)
+		require.True(t, ok, protocol)
+		assert.Equal(t, config.GenericMap{
+			"cert_file": "/etc/odigos/collector-tls/tls.crt",
+			"key_file":  "/etc/odigos/collector-tls/tls.key",
+		}, protocolConfig["tls"], protocol)
+	}
+}
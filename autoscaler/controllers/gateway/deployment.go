@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"path"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -10,6 +11,7 @@ import (
 
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/common/config"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,46 +25,65 @@ import (
 )
 
 const (
-	containerName        = "gateway"
-	containerImage       = "keyval/odigos-collector"
-	containerCommand     = "/odigosotelcol"
-	confDir              = "/conf"
-	configHashAnnotation = "odigos.io/config-hash"
+	containerName         = "gateway"
+	containerImage        = "keyval/odigos-collector"
+	containerCommand      = "/odigosotelcol"
+	confDir               = "/conf"
+	configHashAnnotation  = "odigos.io/config-hash"
+	persistentQueueVolume = "persistent-queue"
+	tlsSecretVolumePrefix = "destination-tls"
 )
 
 func syncDeployment(dests *odigosv1.DestinationList, gateway *odigosv1.CollectorsGroup, configData string,
-	ctx context.Context, c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, memConfig *memoryConfigurations) (*appsv1.Deployment, error) {
+	ctx context.Context, c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, memConfig *memoryConfigurations,
+	scalingConfig *scalingConfigurations, collectorTLS *common.CollectorTLS) (*appsv1.Deployment, error) {
 	logger := log.FromContext(ctx)
-	desiredDeployment, err := getDesiredDeployment(dests, configData, gateway, scheme, imagePullSecrets, odigosVersion, memConfig)
+	desiredDeployment, err := getDesiredDeployment(dests, configData, gateway, scheme, imagePullSecrets, odigosVersion, memConfig, scalingConfig, collectorTLS, nil)
 	if err != nil {
 		logger.Error(err, "Failed to get desired deployment")
 		return nil, err
 	}
 
+	var current *appsv1.Deployment
 	existing := &appsv1.Deployment{}
 	if err := c.Get(ctx, client.ObjectKey{Name: gateway.Name, Namespace: gateway.Namespace}, existing); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.V(0).Info("Creating deployment")
-			newDeployment, err := createDeployment(desiredDeployment, ctx, c)
+			current, err = createDeployment(desiredDeployment, ctx, c)
 			if err != nil {
 				logger.Error(err, "failed to create deployment")
 				return nil, err
 			}
-			return newDeployment, nil
 		} else {
 			logger.Error(err, "failed to get deployment")
 			return nil, err
 		}
+	} else {
+		logger.V(0).Info("Patching deployment")
+		current, err = patchDeployment(existing, desiredDeployment, scalingConfig, ctx, c)
+		if err != nil {
+			logger.Error(err, "failed to patch deployment")
+			return nil, err
+		}
 	}
 
-	logger.V(0).Info("Patching deployment")
-	newDep, err := patchDeployment(existing, desiredDeployment, ctx, c)
-	if err != nil {
-		logger.Error(err, "failed to patch deployment")
+	// the gateway ran as a statefulset before the persistent queue storage was unset.
+	// it keeps receiving data until the deployment is ready, and its claims are kept with the queued data.
+	if err := retirePreviousGatewayWorkload(ctx, c, &appsv1.StatefulSet{}, gateway, deploymentReady(current)); err != nil {
+		logger.Error(err, "failed to delete statefulset")
 		return nil, err
 	}
 
-	return newDep, nil
+	return current, nil
+}
+
+// deploymentReady tells if all the replicas of the current spec of the deployment are ready
+func deploymentReady(dep *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if dep.Spec.Replicas != nil {
+		replicas = *dep.Spec.Replicas
+	}
+	return dep.DeletionTimestamp == nil && dep.Status.ObservedGeneration >= dep.Generation && dep.Status.ReadyReplicas >= replicas
 }
 
 func createDeployment(desired *appsv1.Deployment, ctx context.Context, c client.Client) (*appsv1.Deployment, error) {
@@ -72,10 +93,12 @@ func createDeployment(desired *appsv1.Deployment, ctx context.Context, c client.
 	return desired, nil
 }
 
-func patchDeployment(existing *appsv1.Deployment, desired *appsv1.Deployment, ctx context.Context, c client.Client) (*appsv1.Deployment, error) {
+func patchDeployment(existing *appsv1.Deployment, desired *appsv1.Deployment, scalingConfig *scalingConfigurations,
+	ctx context.Context, c client.Client) (*appsv1.Deployment, error) {
 	logger := log.FromContext(ctx)
 	res, err := controllerutil.CreateOrPatch(ctx, c, existing, func() error {
 		existing.Spec.Template = desired.Spec.Template
+		existing.Spec.Replicas = clampReplicas(existing.Spec.Replicas, scalingConfig)
 		return nil
 	})
 
@@ -88,8 +111,37 @@ func patchDeployment(existing *appsv1.Deployment, desired *appsv1.Deployment, ct
 	return existing, nil
 }
 
+// clampReplicas returns the replicas of the gateway brought back in the bounds of the scaling configuration.
+// the replicas are managed by the HPA, so they are only changed when the bounds change or there is no metrics server to scale the gateway.
+func clampReplicas(replicas *int32, scalingConfig *scalingConfigurations) *int32 {
+	if replicas == nil || *replicas < scalingConfig.minReplicas {
+		return intPtr(scalingConfig.minReplicas)
+	}
+	if *replicas > scalingConfig.maxReplicas {
+		return intPtr(scalingConfig.maxReplicas)
+	}
+	return replicas
+}
+
+// retirePreviousGatewayWorkload deletes the gateway workload of the kind it no longer runs as, if it exists.
+// it is only deleted once the workload replacing it is ready, the gateway is synced again when its status changes.
+func retirePreviousGatewayWorkload(ctx context.Context, c client.Client, workload client.Object, gateway *odigosv1.CollectorsGroup, replacementReady bool) error {
+	if err := c.Get(ctx, client.ObjectKey{Name: gateway.Name, Namespace: gateway.Namespace}, workload); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	logger := log.FromContext(ctx)
+	if !replacementReady {
+		logger.V(0).Info("Keeping previous gateway workload until its replacement is ready", "type", fmt.Sprintf("%T", workload))
+		return nil
+	}
+	logger.V(0).Info("Deleting previous gateway workload", "type", fmt.Sprintf("%T", workload))
+	return client.IgnoreNotFound(c.Delete(ctx, workload))
+}
+
+// getDesiredDeployment returns the deployment of the gateway, the pod template of the statefulset if queueStorage is set
 func getDesiredDeployment(dests *odigosv1.DestinationList, configData string,
-	gateway *odigosv1.CollectorsGroup, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, memConfig *memoryConfigurations) (*appsv1.Deployment, error) {
+	gateway *odigosv1.CollectorsGroup, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, memConfig *memoryConfigurations,
+	scalingConfig *scalingConfigurations, collectorTLS *common.CollectorTLS, queueStorage *odigosv1.PersistentQueueStorage) (*appsv1.Deployment, error) {
 
 	requestMemoryQuantity := resource.MustParse(fmt.Sprintf("%dMi", memConfig.memoryRequestMiB))
 
@@ -100,7 +152,7 @@ func getDesiredDeployment(dests *odigosv1.DestinationList, configData string,
 			Labels:    CommonLabels,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: intPtr(1),
+			Replicas: intPtr(scalingConfig.minReplicas),
 			Selector: &v1.LabelSelector{
 				MatchLabels: CommonLabels,
 			},
@@ -191,6 +243,62 @@ func getDesiredDeployment(dests *odigosv1.DestinationList, configData string,
 		},
 	}
 
+	if scalingConfig.requestCPUm > 0 {
+		requests := desiredDeployment.Spec.Template.Spec.Containers[0].Resources.Requests
+		requests[corev1.ResourceCPU] = resource.MustParse(fmt.Sprintf("%dm", scalingConfig.requestCPUm))
+	}
+
+	persistentQueueSizeMiB, err := config.PersistentQueueSizeMiB(configData)
+	if err != nil {
+		return nil, err
+	}
+	if queueStorage != nil || persistentQueueSizeMiB > 0 {
+		podSpec := &desiredDeployment.Spec.Template.Spec
+		if queueStorage == nil {
+			// the queue is kept across collector restarts, and dropped whenever the pod is deleted.
+			// the pod is evicted if the queue grows beyond the size of the queues of the destinations
+			sizeLimit := resource.MustParse(fmt.Sprintf("%dMi", persistentQueueSizeMiB))
+			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+				Name: persistentQueueVolume,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{
+						SizeLimit: &sizeLimit,
+					},
+				},
+			})
+		}
+		// with a queue storage, the volume is the claim of the replica from the volume claim templates of the statefulset
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      persistentQueueVolume,
+			MountPath: config.PersistentQueueDirectory,
+		})
+	}
+
+	if collectorTLS != nil {
+		common.MountCollectorTLS(&desiredDeployment.Spec.Template, collectorTLS, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	// certificates of destinations exporting over tls, mounted where the exporters tls config reads them
+	for i, secretName := range config.TLSSecretNames(common.ToExporterConfigurerArray(dests)) {
+		volumeName := fmt.Sprintf("%s-%d", tlsSecretVolumePrefix, i)
+		podSpec := &desiredDeployment.Spec.Template.Spec
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+					// a missing secret fails the destinations using it, not the gateway
+					Optional: boolPtr(true),
+				},
+			},
+		})
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: path.Join(config.TLSSecretsDirectory, secretName),
+			ReadOnly:  true,
+		})
+	}
+
 	if len(imagePullSecrets) > 0 {
 		desiredDeployment.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{}
 		for _, secret := range imagePullSecrets {
@@ -198,7 +306,7 @@ func getDesiredDeployment(dests *odigosv1.DestinationList, configData string,
 		}
 	}
 
-	err := ctrl.SetControllerReference(gateway, desiredDeployment, scheme)
+	err = ctrl.SetControllerReference(gateway, desiredDeployment, scheme)
 	if err != nil {
 		return nil, err
 	}
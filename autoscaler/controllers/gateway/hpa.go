@@ -12,29 +12,83 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
-	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	autoscaling "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-const (
-	memoryLimitPercentageForHPA = 75
-)
+func syncHPA(gateway *odigosv1.CollectorsGroup, ctx context.Context, c client.Client, scheme *runtime.Scheme, memConfig *memoryConfigurations,
+	scalingConfig *scalingConfigurations, workloadKind string) error {
+	logger := log.FromContext(ctx)
+	hpa := getDesiredHPA(gateway, memConfig, scalingConfig, workloadKind)
+	if err := controllerutil.SetControllerReference(gateway, hpa, scheme); err != nil {
+		logger.Error(err, "Failed to set controller reference")
+		return err
+	}
 
-var (
-	minReplicas = intPtr(1)
-	maxReplicas = int32(10)
-)
+	hpaBytes, _ := yaml.Marshal(hpa)
 
-func syncHPA(gateway *odigosv1.CollectorsGroup, ctx context.Context, c client.Client, scheme *runtime.Scheme, memConfig *memoryConfigurations) error {
-	logger := log.FromContext(ctx)
+	force := true
+	patchOptions := client.PatchOptions{
+		FieldManager: "odigos",
+		Force:        &force,
+	}
+
+	return c.Patch(ctx, hpa, client.RawPatch(types.ApplyPatchType, hpaBytes), &patchOptions)
+}
+
+// getDesiredHPA returns the HPA of the gateway, workloadKind is the kind the gateway runs as, a Deployment or a StatefulSet
+func getDesiredHPA(gateway *odigosv1.CollectorsGroup, memConfig *memoryConfigurations, scalingConfig *scalingConfigurations,
+	workloadKind string) *autoscaling.HorizontalPodAutoscaler {
 	memLimit := memConfig.gomemlimitMiB * memoryLimitPercentageForHPA / 100.0
 	metricQuantity := resource.MustParse(fmt.Sprintf("%dMi", memLimit))
-	hpa := &autoscaling.HorizontalPodAutoscaler{
+	metrics := []autoscaling.MetricSpec{
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name: corev1.ResourceMemory,
+				Target: autoscaling.MetricTarget{
+					Type:         autoscaling.AverageValueMetricType,
+					AverageValue: &metricQuantity,
+				},
+			},
+		},
+	}
+
+	if scalingConfig.targetCPUUtilizationPercent > 0 {
+		metrics = append(metrics, autoscaling.MetricSpec{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscaling.MetricTarget{
+					Type:               autoscaling.UtilizationMetricType,
+					AverageUtilization: &scalingConfig.targetCPUUtilizationPercent,
+				},
+			},
+		})
+	}
+
+	var behavior *autoscaling.HorizontalPodAutoscalerBehavior
+	if scalingConfig.scaleUpStabilizationWindowSeconds != nil || scalingConfig.scaleDownStabilizationWindowSeconds != nil {
+		behavior = &autoscaling.HorizontalPodAutoscalerBehavior{}
+		if scalingConfig.scaleUpStabilizationWindowSeconds != nil {
+			behavior.ScaleUp = &autoscaling.HPAScalingRules{
+				StabilizationWindowSeconds: scalingConfig.scaleUpStabilizationWindowSeconds,
+			}
+		}
+		if scalingConfig.scaleDownStabilizationWindowSeconds != nil {
+			behavior.ScaleDown = &autoscaling.HPAScalingRules{
+				StabilizationWindowSeconds: scalingConfig.scaleDownStabilizationWindowSeconds,
+			}
+		}
+	}
+
+	return &autoscaling.HorizontalPodAutoscaler{
 		TypeMeta: metav1.TypeMeta{
-			APIVersion: "autoscaling/v2beta2",
+			APIVersion: "autoscaling/v2",
 			Kind:       "HorizontalPodAutoscaler",
 		},
 		ObjectMeta: metav1.ObjectMeta{
@@ -44,37 +98,13 @@ func syncHPA(gateway *odigosv1.CollectorsGroup, ctx context.Context, c client.Cl
 		Spec: autoscaling.HorizontalPodAutoscalerSpec{
 			ScaleTargetRef: autoscaling.CrossVersionObjectReference{
 				APIVersion: "apps/v1",
-				Kind:       "Deployment",
+				Kind:       workloadKind,
 				Name:       gateway.Name,
 			},
-			MinReplicas: minReplicas,
-			MaxReplicas: maxReplicas,
-			Metrics: []autoscaling.MetricSpec{
-				{
-					Type: autoscaling.ResourceMetricSourceType,
-					Resource: &autoscaling.ResourceMetricSource{
-						Name: "memory",
-						Target: autoscaling.MetricTarget{
-							Type:         autoscaling.AverageValueMetricType,
-							AverageValue: &metricQuantity,
-						},
-					},
-				},
-			},
+			MinReplicas: intPtr(scalingConfig.minReplicas),
+			MaxReplicas: scalingConfig.maxReplicas,
+			Metrics:     metrics,
+			Behavior:    behavior,
 		},
 	}
-	if err := controllerutil.SetControllerReference(gateway, hpa, scheme); err != nil {
-		logger.Error(err, "Failed to set controller reference")
-		return err
-	}
-
-	hpaBytes, _ := yaml.Marshal(hpa)
-
-	force := true
-	patchOptions := client.PatchOptions{
-		FieldManager: "odigos",
-		Force:        &force,
-	}
-
-	return c.Patch(ctx, hpa, client.RawPatch(types.ApplyPatchType, hpaBytes), &patchOptions)
 }
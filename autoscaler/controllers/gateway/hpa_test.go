@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscaling "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetDesiredHPA(t *testing.T) {
+	memConfig := &memoryConfigurations{gomemlimitMiB: 400}
+
+	tests := []struct {
+		name             string
+		gatewayConfig    *odigosv1.CollectorGatewayConfiguration
+		workloadKind     string
+		expectedMin      int32
+		expectedMax      int32
+		expectedCPU      *int32
+		expectedBehavior *autoscaling.HorizontalPodAutoscalerBehavior
+	}{
+		{
+			name:         "defaults",
+			workloadKind: "Deployment",
+			expectedMin:  1,
+			expectedMax:  10,
+		},
+		{
+			name:          "min replicas above max replicas",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{MinReplicas: 4, MaxReplicas: 2},
+			workloadKind:  "Deployment",
+			expectedMin:   4,
+			expectedMax:   4,
+		},
+		{
+			name:          "cpu target",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{TargetCPUUtilizationPercent: 60},
+			workloadKind:  "Deployment",
+			expectedMin:   1,
+			expectedMax:   10,
+			expectedCPU:   intPtr(60),
+		},
+		{
+			name: "stabilization windows",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{
+				ScaleDownStabilizationWindowSeconds: intPtr(600),
+			},
+			workloadKind: "Deployment",
+			expectedMin:  1,
+			expectedMax:  10,
+			expectedBehavior: &autoscaling.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscaling.HPAScalingRules{StabilizationWindowSeconds: intPtr(600)},
+			},
+		},
+		{
+			name:         "statefulset",
+			workloadKind: "StatefulSet",
+			expectedMin:  1,
+			expectedMax:  10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			odigosConfig := &odigosv1.OdigosConfiguration{
+				Spec: odigosv1.OdigosConfigurationSpec{CollectorGateway: tt.gatewayConfig},
+			}
+			hpa := getDesiredHPA(newTestGateway(), memConfig, getScalingConfigurations(odigosConfig), tt.workloadKind)
+
+			assert.Equal(t, kubeObjectName, hpa.Name)
+			assert.Equal(t, testNamespace, hpa.Namespace)
+			assert.Equal(t, autoscaling.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: tt.workloadKind, Name: kubeObjectName}, hpa.Spec.ScaleTargetRef)
+			assert.Equal(t, tt.expectedMin, *hpa.Spec.MinReplicas)
+			assert.Equal(t, tt.expectedMax, hpa.Spec.MaxReplicas)
+			assert.Equal(t, tt.expectedBehavior, hpa.Spec.Behavior)
+
+			// the memory target is 75% of GOMEMLIMIT
+			memMetric := hpa.Spec.Metrics[0].Resource
+			assert.Equal(t, corev1.ResourceMemory, memMetric.Name)
+			assert.Equal(t, autoscaling.AverageValueMetricType, memMetric.Target.Type)
+			assert.Equal(t, resource.MustParse("300Mi"), *memMetric.Target.AverageValue)
+
+			if tt.expectedCPU == nil {
+				assert.Len(t, hpa.Spec.Metrics, 1)
+				return
+			}
+			require.Len(t, hpa.Spec.Metrics, 2)
+			cpuMetric := hpa.Spec.Metrics[1].Resource
+			assert.Equal(t, corev1.ResourceCPU, cpuMetric.Name)
+			assert.Equal(t, autoscaling.UtilizationMetricType, cpuMetric.Target.Type)
+			assert.Equal(t, tt.expectedCPU, cpuMetric.Target.AverageUtilization)
+		})
+	}
+}
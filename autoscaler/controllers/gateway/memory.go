@@ -38,13 +38,23 @@ func getMemoryConfigurations(odigosConfig *odigosv1.OdigosConfiguration) *memory
 		memoryLimiterLimitMiB = odigosConfig.Spec.CollectorGateway.MemoryLimiterLimitMiB
 	}
 
+	// the processor rejects a hard limit that is not positive
+	if memoryLimiterLimitMiB <= 0 {
+		memoryLimiterLimitMiB = memoryRequestMiB * (100 - defaultMemoryLimiterSpikePercentage) / 100.0
+	}
+	if memoryLimiterLimitMiB < 1 {
+		memoryLimiterLimitMiB = 1
+	}
+
+	// a spike limit of 0 makes the processor fall back to its own default of 20% of the hard limit
 	memoryLimiterSpikeLimitMiB := memoryLimiterLimitMiB * defaultMemoryLimiterSpikePercentage / 100.0
-	if odigosConfig.Spec.CollectorGateway != nil && odigosConfig.Spec.CollectorGateway.MemoryLimiterSpikeLimitMiB > 0 {
+	// the processor rejects a spike limit that is not lower than the hard limit
+	if odigosConfig.Spec.CollectorGateway != nil && odigosConfig.Spec.CollectorGateway.MemoryLimiterSpikeLimitMiB > 0 && odigosConfig.Spec.CollectorGateway.MemoryLimiterSpikeLimitMiB < memoryLimiterLimitMiB {
 		memoryLimiterSpikeLimitMiB = odigosConfig.Spec.CollectorGateway.MemoryLimiterSpikeLimitMiB
 	}
 
 	gomemlimitMiB := int(memoryLimiterLimitMiB * defaultGoMemLimitPercentage / 100.0)
-	if odigosConfig.Spec.CollectorGateway != nil && odigosConfig.Spec.CollectorGateway.GoMemLimitMib != 0 {
+	if odigosConfig.Spec.CollectorGateway != nil && odigosConfig.Spec.CollectorGateway.GoMemLimitMib > 0 {
 		gomemlimitMiB = odigosConfig.Spec.CollectorGateway.GoMemLimitMib
 	}
 
@@ -0,0 +1,82 @@
+package gateway
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMemoryConfigurations(t *testing.T) {
+	tests := []struct {
+		name          string
+		gatewayConfig *odigosv1.CollectorGatewayConfiguration
+		expected      memoryConfigurations
+	}{
+		{
+			name: "defaults",
+			expected: memoryConfigurations{
+				memoryRequestMiB:           500,
+				memoryLimiterLimitMiB:      450,
+				memoryLimiterSpikeLimitMiB: 90,
+				gomemlimitMiB:              360,
+			},
+		},
+		{
+			name:          "memory request",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{RequestMemoryMiB: 1000},
+			expected: memoryConfigurations{
+				memoryRequestMiB:           1000,
+				memoryLimiterLimitMiB:      950,
+				memoryLimiterSpikeLimitMiB: 190,
+				gomemlimitMiB:              760,
+			},
+		},
+		{
+			name:          "memory request too small to leave 50MiB",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{RequestMemoryMiB: 40},
+			expected: memoryConfigurations{
+				memoryRequestMiB:           40,
+				memoryLimiterLimitMiB:      32,
+				memoryLimiterSpikeLimitMiB: 6,
+				gomemlimitMiB:              25,
+			},
+		},
+		{
+			name: "spike limit not lower than the memory limiter limit",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{
+				MemoryLimiterLimitMiB:      300,
+				MemoryLimiterSpikeLimitMiB: 300,
+			},
+			expected: memoryConfigurations{
+				memoryRequestMiB:           500,
+				memoryLimiterLimitMiB:      300,
+				memoryLimiterSpikeLimitMiB: 60,
+				gomemlimitMiB:              240,
+			},
+		},
+		{
+			name: "configured memory limiter",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{
+				MemoryLimiterLimitMiB:      300,
+				MemoryLimiterSpikeLimitMiB: 100,
+				GoMemLimitMib:              200,
+			},
+			expected: memoryConfigurations{
+				memoryRequestMiB:           500,
+				memoryLimiterLimitMiB:      300,
+				memoryLimiterSpikeLimitMiB: 100,
+				gomemlimitMiB:              200,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			odigosConfig := &odigosv1.OdigosConfiguration{
+				Spec: odigosv1.OdigosConfigurationSpec{CollectorGateway: tt.gatewayConfig},
+			}
+			assert.Equal(t, &tt.expected, getMemoryConfigurations(odigosConfig))
+		})
+	}
+}
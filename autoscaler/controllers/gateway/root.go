@@ -78,8 +78,15 @@ func syncGateway(dests *odigosv1.DestinationList, processors *odigosv1.Processor
 	logger.V(0).Info("Syncing gateway")
 
 	memConfig := getMemoryConfigurations(odigosConfig)
+	scalingConfig := getScalingConfigurations(odigosConfig)
 
-	configData, err := syncConfigMap(dests, processors, gateway, ctx, c, scheme, memConfig)
+	collectorTLS, err := syncCollectorTLS(ctx, c, gateway, scheme, odigosConfig)
+	if err != nil {
+		logger.Error(err, "Failed to sync collectors tls certificate")
+		return err
+	}
+
+	configData, err := syncConfigMap(dests, processors, gateway, ctx, c, scheme, memConfig, collectorTLS)
 	if err != nil {
 		logger.Error(err, "Failed to sync config map")
 		return err
@@ -97,20 +104,33 @@ func syncGateway(dests *odigosv1.DestinationList, processors *odigosv1.Processor
 		return err
 	}
 
-	dep, err := syncDeployment(dests, gateway, configData, ctx, c, scheme, imagePullSecrets, odigosVersion, memConfig)
-	if err != nil {
-		logger.Error(err, "Failed to sync deployment")
-		return err
+	var readyReplicas int32
+	workloadKind := "Deployment"
+	if queueStorage := getPersistentQueueStorage(odigosConfig); queueStorage != nil {
+		ss, err := syncStatefulSet(dests, gateway, configData, ctx, c, scheme, imagePullSecrets, odigosVersion, memConfig, scalingConfig, collectorTLS, queueStorage)
+		if err != nil {
+			logger.Error(err, "Failed to sync statefulset")
+			return err
+		}
+		readyReplicas = ss.Status.ReadyReplicas
+		workloadKind = "StatefulSet"
+	} else {
+		dep, err := syncDeployment(dests, gateway, configData, ctx, c, scheme, imagePullSecrets, odigosVersion, memConfig, scalingConfig, collectorTLS)
+		if err != nil {
+			logger.Error(err, "Failed to sync deployment")
+			return err
+		}
+		readyReplicas = dep.Status.ReadyReplicas
 	}
 
 	if isMetricsServerInstalled(ctx, c) {
-		err = syncHPA(gateway, ctx, c, scheme, memConfig)
+		err = syncHPA(gateway, ctx, c, scheme, memConfig, scalingConfig, workloadKind)
 		if err != nil {
 			logger.Error(err, "Failed to sync HPA")
 		}
 	}
 
-	isReady := dep.Status.ReadyReplicas > 0
+	isReady := readyReplicas > 0
 	if !gateway.Status.Ready && isReady {
 		err := c.Status().Patch(ctx, gateway, client.RawPatch(
 			types.MergePatchType,
@@ -142,3 +162,11 @@ func isMetricsServerInstalled(ctx context.Context, c client.Client) bool {
 	logger.V(0).Info("Metrics server found, creating HPA for Gateway")
 	return true
 }
+
+// getPersistentQueueStorage returns the storage of the persistent queues, nil if they are stored on an emptyDir
+func getPersistentQueueStorage(odigosConfig *odigosv1.OdigosConfiguration) *odigosv1.PersistentQueueStorage {
+	if odigosConfig.Spec.CollectorGateway == nil {
+		return nil
+	}
+	return odigosConfig.Spec.CollectorGateway.PersistentQueueStorage
+}
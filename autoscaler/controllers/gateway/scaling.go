@@ -0,0 +1,76 @@
+package gateway
+
+import odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+
+const (
+	defaultMinReplicas = 1
+	defaultMaxReplicas = 10
+
+	// cpu utilization is measured relative to the cpu request,
+	// so a cpu request is set when scaling on cpu even if none is configured
+	defaultRequestCPUmForHPA = 500
+
+	// the HPA scales on memory when the average usage of the replicas crosses this percentage of GOMEMLIMIT
+	memoryLimitPercentageForHPA = 75
+)
+
+type scalingConfigurations struct {
+	minReplicas int32
+	maxReplicas int32
+	// 0 if no cpu is requested
+	requestCPUm int
+	// 0 if the deployment is not scaled on cpu
+	targetCPUUtilizationPercent         int32
+	scaleUpStabilizationWindowSeconds   *int32
+	scaleDownStabilizationWindowSeconds *int32
+}
+
+func getScalingConfigurations(odigosConfig *odigosv1.OdigosConfiguration) *scalingConfigurations {
+	gatewayConfig := odigosConfig.Spec.CollectorGateway
+	if gatewayConfig == nil {
+		gatewayConfig = &odigosv1.CollectorGatewayConfiguration{}
+	}
+
+	minReplicas := defaultMinReplicas
+	if gatewayConfig.MinReplicas > 0 {
+		minReplicas = gatewayConfig.MinReplicas
+	}
+
+	maxReplicas := defaultMaxReplicas
+	if gatewayConfig.MaxReplicas > 0 {
+		maxReplicas = gatewayConfig.MaxReplicas
+	}
+	if maxReplicas < minReplicas {
+		maxReplicas = minReplicas
+	}
+
+	targetCPUUtilizationPercent := 0
+	if gatewayConfig.TargetCPUUtilizationPercent > 0 {
+		targetCPUUtilizationPercent = gatewayConfig.TargetCPUUtilizationPercent
+	}
+
+	requestCPUm := 0
+	if gatewayConfig.RequestCPUm > 0 {
+		requestCPUm = gatewayConfig.RequestCPUm
+	} else if targetCPUUtilizationPercent > 0 {
+		requestCPUm = defaultRequestCPUmForHPA
+	}
+
+	return &scalingConfigurations{
+		minReplicas:                         int32(minReplicas),
+		maxReplicas:                         int32(maxReplicas),
+		requestCPUm:                         requestCPUm,
+		targetCPUUtilizationPercent:         int32(targetCPUUtilizationPercent),
+		scaleUpStabilizationWindowSeconds:   nonNegative(gatewayConfig.ScaleUpStabilizationWindowSeconds),
+		scaleDownStabilizationWindowSeconds: nonNegative(gatewayConfig.ScaleDownStabilizationWindowSeconds),
+	}
+}
+
+// nonNegative drops negative windows, which the kubernetes api rejects, so the default is used instead
+func nonNegative(seconds *int32) *int32 {
+	if seconds == nil || *seconds < 0 {
+		return nil
+	}
+	value := *seconds
+	return &value
+}
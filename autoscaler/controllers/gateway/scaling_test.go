@@ -0,0 +1,196 @@
+package gateway
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGetScalingConfigurations(t *testing.T) {
+	tests := []struct {
+		name          string
+		gatewayConfig *odigosv1.CollectorGatewayConfiguration
+		expected      scalingConfigurations
+	}{
+		{
+			name: "defaults",
+			expected: scalingConfigurations{
+				minReplicas: 1,
+				maxReplicas: 10,
+			},
+		},
+		{
+			name:          "configured replicas",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{MinReplicas: 2, MaxReplicas: 5},
+			expected: scalingConfigurations{
+				minReplicas: 2,
+				maxReplicas: 5,
+			},
+		},
+		{
+			name:          "min replicas above max replicas",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{MinReplicas: 8, MaxReplicas: 3},
+			expected: scalingConfigurations{
+				minReplicas: 8,
+				maxReplicas: 8,
+			},
+		},
+		{
+			name:          "min replicas above the default max replicas",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{MinReplicas: 12},
+			expected: scalingConfigurations{
+				minReplicas: 12,
+				maxReplicas: 12,
+			},
+		},
+		{
+			name:          "cpu request without cpu target",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{RequestCPUm: 250},
+			expected: scalingConfigurations{
+				minReplicas: 1,
+				maxReplicas: 10,
+				requestCPUm: 250,
+			},
+		},
+		{
+			name:          "cpu target without cpu request",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{TargetCPUUtilizationPercent: 70},
+			expected: scalingConfigurations{
+				minReplicas:                 1,
+				maxReplicas:                 10,
+				requestCPUm:                 500,
+				targetCPUUtilizationPercent: 70,
+			},
+		},
+		{
+			name:          "cpu target with cpu request",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{TargetCPUUtilizationPercent: 70, RequestCPUm: 1000},
+			expected: scalingConfigurations{
+				minReplicas:                 1,
+				maxReplicas:                 10,
+				requestCPUm:                 1000,
+				targetCPUUtilizationPercent: 70,
+			},
+		},
+		{
+			name: "stabilization windows",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{
+				ScaleUpStabilizationWindowSeconds:   intPtr(0),
+				ScaleDownStabilizationWindowSeconds: intPtr(600),
+			},
+			expected: scalingConfigurations{
+				minReplicas:                         1,
+				maxReplicas:                         10,
+				scaleUpStabilizationWindowSeconds:   intPtr(0),
+				scaleDownStabilizationWindowSeconds: intPtr(600),
+			},
+		},
+		{
+			name: "negative stabilization windows",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{
+				ScaleUpStabilizationWindowSeconds:   intPtr(-1),
+				ScaleDownStabilizationWindowSeconds: intPtr(-30),
+			},
+			expected: scalingConfigurations{
+				minReplicas: 1,
+				maxReplicas: 10,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			odigosConfig := &odigosv1.OdigosConfiguration{
+				Spec: odigosv1.OdigosConfigurationSpec{CollectorGateway: tt.gatewayConfig},
+			}
+			assert.Equal(t, &tt.expected, getScalingConfigurations(odigosConfig))
+		})
+	}
+}
+
+func TestClampReplicas(t *testing.T) {
+	scalingConfig := &scalingConfigurations{minReplicas: 2, maxReplicas: 5}
+
+	tests := []struct {
+		name     string
+		replicas *int32
+		expected int32
+	}{
+		{
+			name:     "unset",
+			expected: 2,
+		},
+		{
+			name:     "below min replicas",
+			replicas: intPtr(1),
+			expected: 2,
+		},
+		{
+			name:     "in bounds",
+			replicas: intPtr(3),
+			expected: 3,
+		},
+		{
+			name:     "above max replicas",
+			replicas: intPtr(8),
+			expected: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, *clampReplicas(tt.replicas, scalingConfig))
+		})
+	}
+}
+
+func TestGetDesiredDeploymentCPURequest(t *testing.T) {
+	scheme := newTestScheme(t)
+	memConfig := getMemoryConfigurations(&odigosv1.OdigosConfiguration{})
+	configData, err, _ := config.Calculate(nil, nil, config.GenericMap{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		gatewayConfig *odigosv1.CollectorGatewayConfiguration
+		expectedCPU   string
+	}{
+		{
+			name: "no cpu request",
+		},
+		{
+			name:          "cpu target without cpu request",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{TargetCPUUtilizationPercent: 70},
+			expectedCPU:   "500m",
+		},
+		{
+			name:          "cpu request",
+			gatewayConfig: &odigosv1.CollectorGatewayConfiguration{TargetCPUUtilizationPercent: 70, RequestCPUm: 1000},
+			expectedCPU:   "1000m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			odigosConfig := &odigosv1.OdigosConfiguration{
+				Spec: odigosv1.OdigosConfigurationSpec{CollectorGateway: tt.gatewayConfig},
+			}
+			dep, err := getDesiredDeployment(&odigosv1.DestinationList{}, configData, newTestGateway(), scheme, nil, "v1", memConfig,
+				getScalingConfigurations(odigosConfig), nil, nil)
+			require.NoError(t, err)
+
+			cpu, ok := dep.Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU]
+			if tt.expectedCPU == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, resource.MustParse(tt.expectedCPU), cpu)
+		})
+	}
+}
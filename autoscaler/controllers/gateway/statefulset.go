@@ -0,0 +1,159 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/autoscaler/controllers/common"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const defaultPersistentQueueStorageSizeMiB = 10240
+
+// syncStatefulSet runs the gateway as a statefulset, so each replica keeps the claim its persistent queues are stored on
+func syncStatefulSet(dests *odigosv1.DestinationList, gateway *odigosv1.CollectorsGroup, configData string,
+	ctx context.Context, c client.Client, scheme *runtime.Scheme, imagePullSecrets []string, odigosVersion string, memConfig *memoryConfigurations,
+	scalingConfig *scalingConfigurations, collectorTLS *common.CollectorTLS, queueStorage *odigosv1.PersistentQueueStorage) (*appsv1.StatefulSet, error) {
+	logger := log.FromContext(ctx)
+	desiredDeployment, err := getDesiredDeployment(dests, configData, gateway, scheme, imagePullSecrets, odigosVersion, memConfig, scalingConfig, collectorTLS, queueStorage)
+	if err != nil {
+		logger.Error(err, "Failed to get desired statefulset")
+		return nil, err
+	}
+	desired := getDesiredStatefulSet(desiredDeployment, queueStorage)
+
+	current, err := applyStatefulSet(ctx, c, desired, scalingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// the gateway ran as a deployment before the persistent queue storage was set.
+	// it keeps receiving data until the statefulset is ready, so the gateway is never down during the switch.
+	if err := retirePreviousGatewayWorkload(ctx, c, &appsv1.Deployment{}, gateway, statefulSetReady(current)); err != nil {
+		logger.Error(err, "failed to delete deployment")
+		return nil, err
+	}
+	return current, nil
+}
+
+func applyStatefulSet(ctx context.Context, c client.Client, desired *appsv1.StatefulSet, scalingConfig *scalingConfigurations) (*appsv1.StatefulSet, error) {
+	logger := log.FromContext(ctx)
+
+	existing := &appsv1.StatefulSet{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "failed to get statefulset")
+			return nil, err
+		}
+		logger.V(0).Info("Creating statefulset")
+		if err := c.Create(ctx, desired); err != nil {
+			logger.Error(err, "failed to create statefulset")
+			return nil, err
+		}
+		return desired, nil
+	}
+
+	if volumeClaimTemplatesChanged(existing, desired) {
+		// the volume claim templates cannot be updated, the statefulset is created again and adopts the running pods and their claims
+		logger.V(0).Info("Recreating statefulset for the new persistent queue storage")
+		if err := c.Delete(ctx, existing, client.PropagationPolicy(v1.DeletePropagationOrphan)); err != nil {
+			logger.Error(err, "failed to delete statefulset")
+			return nil, err
+		}
+		// it is created on the next sync, once the deletion is done
+		return existing, nil
+	}
+
+	logger.V(0).Info("Patching statefulset")
+	res, err := controllerutil.CreateOrPatch(ctx, c, existing, func() error {
+		existing.Spec.Template = desired.Spec.Template
+		existing.Spec.Replicas = clampReplicas(existing.Spec.Replicas, scalingConfig)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "failed to patch statefulset")
+		return nil, err
+	}
+
+	logger.V(0).Info("Statefulset patched", "result", res)
+	return existing, nil
+}
+
+// statefulSetReady tells if all the replicas of the current spec of the statefulset are ready
+func statefulSetReady(ss *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if ss.Spec.Replicas != nil {
+		replicas = *ss.Spec.Replicas
+	}
+	return ss.DeletionTimestamp == nil && ss.Status.ObservedGeneration >= ss.Generation && ss.Status.ReadyReplicas >= replicas
+}
+
+func getDesiredStatefulSet(desiredDeployment *appsv1.Deployment, queueStorage *odigosv1.PersistentQueueStorage) *appsv1.StatefulSet {
+	sizeMiB := defaultPersistentQueueStorageSizeMiB
+	if queueStorage.SizeMiB > 0 {
+		sizeMiB = queueStorage.SizeMiB
+	}
+	claim := corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name: persistentQueueVolume,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(fmt.Sprintf("%dMi", sizeMiB)),
+				},
+			},
+		},
+	}
+	if queueStorage.StorageClassName != "" {
+		storageClassName := queueStorage.StorageClassName
+		claim.Spec.StorageClassName = &storageClassName
+	}
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: desiredDeployment.ObjectMeta,
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    desiredDeployment.Spec.Replicas,
+			Selector:    desiredDeployment.Spec.Selector,
+			Template:    desiredDeployment.Spec.Template,
+			ServiceName: kubeObjectName,
+			// the replicas do not depend on each other, so they are started and stopped together like the pods of a deployment
+			PodManagementPolicy: appsv1.ParallelPodManagement,
+			// the claims are kept when the replicas are scaled down, so their queues are sent once they are scaled up again
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{claim},
+		},
+	}
+}
+
+func volumeClaimTemplatesChanged(existing *appsv1.StatefulSet, desired *appsv1.StatefulSet) bool {
+	if len(existing.Spec.VolumeClaimTemplates) != len(desired.Spec.VolumeClaimTemplates) {
+		return true
+	}
+	for i, desiredClaim := range desired.Spec.VolumeClaimTemplates {
+		existingClaim := existing.Spec.VolumeClaimTemplates[i]
+		if existingClaim.Name != desiredClaim.Name {
+			return true
+		}
+		// the storage class is defaulted by the api server when it is not set
+		if desiredClaim.Spec.StorageClassName != nil &&
+			(existingClaim.Spec.StorageClassName == nil || *existingClaim.Spec.StorageClassName != *desiredClaim.Spec.StorageClassName) {
+			return true
+		}
+		existingSize := existingClaim.Spec.Resources.Requests[corev1.ResourceStorage]
+		desiredSize := desiredClaim.Spec.Resources.Requests[corev1.ResourceStorage]
+		if existingSize.Cmp(desiredSize) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, odigosv1.AddToScheme(scheme))
+	return scheme
+}
+
+func newTestGateway() *odigosv1.CollectorsGroup {
+	return &odigosv1.CollectorsGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      kubeObjectName,
+			Namespace: testNamespace,
+			UID:       "gateway-uid",
+		},
+		Spec: odigosv1.CollectorsGroupSpec{
+			Role: odigosv1.CollectorsGroupRoleClusterGateway,
+		},
+	}
+}
+
+// newTestQueueDestinations returns a destination with a persistent queue, and the gateway config of it
+func newTestQueueDestinations(t *testing.T) (*odigosv1.DestinationList, string) {
+	dests := &odigosv1.DestinationList{
+		Items: []odigosv1.Destination{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "otlp-dest", Namespace: testNamespace},
+				Spec: odigosv1.DestinationSpec{
+					Type:            "otlphttp",
+					DestinationName: "otlp",
+					Data:            map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318"},
+					Signals:         []common.ObservabilitySignal{common.TracesObservabilitySignal},
+					PersistentQueue: &odigosv1.PersistentQueue{QueueSize: 300},
+				},
+			},
+		},
+	}
+	configData, err, _ := config.Calculate(commonconf.ToExporterConfigurerArray(dests), nil, config.GenericMap{})
+	require.NoError(t, err)
+	return dests, configData
+}
+
+func findVolume(podSpec corev1.PodSpec, name string) *corev1.Volume {
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == name {
+			return &podSpec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+func findVolumeMount(container corev1.Container, name string) *corev1.VolumeMount {
+	for i := range container.VolumeMounts {
+		if container.VolumeMounts[i].Name == name {
+			return &container.VolumeMounts[i]
+		}
+	}
+	return nil
+}
+
+func assertClaimSize(t *testing.T, expected string, ss *appsv1.StatefulSet) {
+	size := ss.Spec.VolumeClaimTemplates[0].Spec.Resources.Requests[corev1.ResourceStorage]
+	expectedSize := resource.MustParse(expected)
+	assert.Zero(t, expectedSize.Cmp(size), "claim size %s, expected %s", size.String(), expected)
+}
+
+func TestGetDesiredDeploymentPersistentQueue(t *testing.T) {
+	scheme := newTestScheme(t)
+	memConfig := getMemoryConfigurations(&odigosv1.OdigosConfiguration{})
+	scalingConfig := getScalingConfigurations(&odigosv1.OdigosConfiguration{})
+	queueDests, queueConfigData := newTestQueueDestinations(t)
+	noQueueData, err, _ := config.Calculate(nil, nil, config.GenericMap{})
+	require.NoError(t, err)
+
+	t.Run("no persistent queue", func(t *testing.T) {
+		dep, err := getDesiredDeployment(&odigosv1.DestinationList{}, noQueueData, newTestGateway(), scheme, nil, "v1", memConfig, scalingConfig, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, findVolume(dep.Spec.Template.Spec, persistentQueueVolume))
+		assert.Nil(t, findVolumeMount(dep.Spec.Template.Spec.Containers[0], persistentQueueVolume))
+	})
+
+	t.Run("emptyDir sized by the queue", func(t *testing.T) {
+		dep, err := getDesiredDeployment(queueDests, queueConfigData, newTestGateway(), scheme, nil, "v1", memConfig, scalingConfig, nil, nil)
+		require.NoError(t, err)
+		volume := findVolume(dep.Spec.Template.Spec, persistentQueueVolume)
+		require.NotNil(t, volume)
+		require.NotNil(t, volume.EmptyDir)
+		assert.Equal(t, resource.MustParse("300Mi"), *volume.EmptyDir.SizeLimit)
+		mount := findVolumeMount(dep.Spec.Template.Spec.Containers[0], persistentQueueVolume)
+		require.NotNil(t, mount)
+		assert.Equal(t, config.PersistentQueueDirectory, mount.MountPath)
+	})
+
+	t.Run("claim of the statefulset", func(t *testing.T) {
+		queueStorage := &odigosv1.PersistentQueueStorage{StorageClassName: "fast", SizeMiB: 2048}
+		dep, err := getDesiredDeployment(queueDests, queueConfigData, newTestGateway(), scheme, nil, "v1", memConfig, scalingConfig, nil, queueStorage)
+		require.NoError(t, err)
+		// the volume comes from the volume claim templates
+		assert.Nil(t, findVolume(dep.Spec.Template.Spec, persistentQueueVolume))
+		assert.NotNil(t, findVolumeMount(dep.Spec.Template.Spec.Containers[0], persistentQueueVolume))
+
+		ss := getDesiredStatefulSet(dep, queueStorage)
+		require.Len(t, ss.Spec.VolumeClaimTemplates, 1)
+		claim := ss.Spec.VolumeClaimTemplates[0]
+		assert.Equal(t, persistentQueueVolume, claim.Name)
+		assert.Equal(t, "fast", *claim.Spec.StorageClassName)
+		assert.Equal(t, resource.MustParse("2048Mi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+		assert.Equal(t, dep.Spec.Template, ss.Spec.Template)
+		assert.Equal(t, dep.OwnerReferences, ss.OwnerReferences)
+	})
+
+	t.Run("default claim", func(t *testing.T) {
+		dep, err := getDesiredDeployment(queueDests, queueConfigData, newTestGateway(), scheme, nil, "v1", memConfig, scalingConfig, nil, &odigosv1.PersistentQueueStorage{})
+		require.NoError(t, err)
+		claim := getDesiredStatefulSet(dep, &odigosv1.PersistentQueueStorage{}).Spec.VolumeClaimTemplates[0]
+		assert.Nil(t, claim.Spec.StorageClassName)
+		assert.Equal(t, resource.MustParse("10240Mi"), claim.Spec.Resources.Requests[corev1.ResourceStorage])
+	})
+}
+
+func TestSyncStatefulSetReplacesDeployment(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme(t)
+	gateway := newTestGateway()
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).
+		WithStatusSubresource(&appsv1.Deployment{}, &appsv1.StatefulSet{}).Build()
+	memConfig := getMemoryConfigurations(&odigosv1.OdigosConfiguration{})
+	scalingConfig := getScalingConfigurations(&odigosv1.OdigosConfiguration{})
+	dests, configData := newTestQueueDestinations(t)
+	key := client.ObjectKey{Name: gateway.Name, Namespace: gateway.Namespace}
+
+	_, err := syncDeployment(dests, gateway, configData, ctx, c, scheme, nil, "v1", memConfig, scalingConfig, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, &appsv1.Deployment{}))
+
+	queueStorage := &odigosv1.PersistentQueueStorage{SizeMiB: 1024}
+	_, err = syncStatefulSet(dests, gateway, configData, ctx, c, scheme, nil, "v1", memConfig, scalingConfig, nil, queueStorage)
+	require.NoError(t, err)
+	// the deployment keeps receiving data until the statefulset is ready
+	require.NoError(t, c.Get(ctx, key, &appsv1.Deployment{}))
+	markStatefulSetReady(t, ctx, c, key)
+	_, err = syncStatefulSet(dests, gateway, configData, ctx, c, scheme, nil, "v1", memConfig, scalingConfig, nil, queueStorage)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, &appsv1.Deployment{})))
+	var ss appsv1.StatefulSet
+	require.NoError(t, c.Get(ctx, key, &ss))
+	assertClaimSize(t, "1024Mi", &ss)
+
+	// the claims outlive a change of the pod template, which only patches the statefulset
+	_, err = syncStatefulSet(dests, gateway, configData+"\n", ctx, c, scheme, nil, "v2", memConfig, scalingConfig, nil, queueStorage)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, &ss))
+	assert.Len(t, ss.Spec.VolumeClaimTemplates, 1)
+
+	// a new claim size cannot be updated in place, the statefulset is deleted without its pods and created again
+	queueStorage = &odigosv1.PersistentQueueStorage{SizeMiB: 4096}
+	_, err = syncStatefulSet(dests, gateway, configData, ctx, c, scheme, nil, "v2", memConfig, scalingConfig, nil, queueStorage)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, &appsv1.StatefulSet{})))
+	_, err = syncStatefulSet(dests, gateway, configData, ctx, c, scheme, nil, "v2", memConfig, scalingConfig, nil, queueStorage)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, &ss))
+	assertClaimSize(t, "4096Mi", &ss)
+
+	// unsetting the storage runs the gateway as a deployment again
+	_, err = syncDeployment(dests, gateway, configData, ctx, c, scheme, nil, "v2", memConfig, scalingConfig, nil)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, &appsv1.StatefulSet{}))
+	markDeploymentReady(t, ctx, c, key)
+	_, err = syncDeployment(dests, gateway, configData, ctx, c, scheme, nil, "v2", memConfig, scalingConfig, nil)
+	require.NoError(t, err)
+	assert.True(t, apierrors.IsNotFound(c.Get(ctx, key, &appsv1.StatefulSet{})))
+	require.NoError(t, c.Get(ctx, key, &appsv1.Deployment{}))
+}
+
+func markStatefulSetReady(t *testing.T, ctx context.Context, c client.Client, key client.ObjectKey) {
+	var ss appsv1.StatefulSet
+	require.NoError(t, c.Get(ctx, key, &ss))
+	ss.Status.ObservedGeneration = ss.Generation
+	ss.Status.ReadyReplicas = *ss.Spec.Replicas
+	require.NoError(t, c.Status().Update(ctx, &ss))
+}
+
+func markDeploymentReady(t *testing.T, ctx context.Context, c client.Client, key client.ObjectKey) {
+	var dep appsv1.Deployment
+	require.NoError(t, c.Get(ctx, key, &dep))
+	dep.Status.ObservedGeneration = dep.Generation
+	dep.Status.ReadyReplicas = *dep.Spec.Replicas
+	require.NoError(t, c.Status().Update(ctx, &dep))
+}
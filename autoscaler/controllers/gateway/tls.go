@@ -0,0 +1,320 @@
+package gateway
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/autoscaler/controllers/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	collectorTLSValidity = 365 * 24 * time.Hour
+	// the certificate is issued again once less than this is left until it expires
+	collectorTLSRenewBefore = 30 * 24 * time.Hour
+
+	collectorTLSCAValidity = 5 * 365 * 24 * time.Hour
+	// a new ca is added to the trust bundle once less than this is left until the current ca expires
+	collectorTLSCARenewBefore = 90 * 24 * time.Hour
+	// how long a new ca is published in the trust bundle before it signs the certificate of the gateway,
+	// so the node collectors trust it by the time the gateway serves a certificate signed by it
+	collectorTLSCAGracePeriod = 7 * 24 * time.Hour
+
+	// the keys of the ca private keys in the secret, they are not mounted in any of the collectors
+	collectorTLSCAPrivateKeyKey     = "ca.key"
+	collectorTLSNextCAPrivateKeyKey = "next-ca.key"
+
+	// CollectorTLSRenewCheckInterval is how often the issued certificate is checked for renewal
+	CollectorTLSRenewCheckInterval = 12 * time.Hour
+)
+
+// syncCollectorTLS returns the certificate of the gateway for the telemetry from the node collectors, nil if tls is disabled.
+// unless the odigos configuration points at a secret managed by the user, the autoscaler issues a self-signed certificate and rotates it.
+func syncCollectorTLS(ctx context.Context, c client.Client, gateway *odigosv1.CollectorsGroup, scheme *runtime.Scheme,
+	odigosConfig *odigosv1.OdigosConfiguration) (*common.CollectorTLS, error) {
+	secretName := common.CollectorTLSSecretNameFromConfig(odigosConfig)
+	if secretName == "" {
+		return nil, nil
+	}
+	if secretName != common.CollectorTLSSecretName {
+		return common.GetCollectorTLS(ctx, c, gateway.Namespace, odigosConfig)
+	}
+
+	logger := log.FromContext(ctx)
+	existing := &corev1.Secret{}
+	found := true
+	if err := c.Get(ctx, client.ObjectKey{Namespace: gateway.Namespace, Name: secretName}, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		found = false
+	}
+
+	data, changed, err := renewCollectorTLS(existing.Data, gateway.Namespace, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if found && !changed {
+		return common.CollectorTLSFromSecret(existing)
+	}
+
+	if !found {
+		desired := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretName,
+				Namespace: gateway.Namespace,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		if err := ctrl.SetControllerReference(gateway, desired, scheme); err != nil {
+			return nil, err
+		}
+		logger.V(0).Info("Issuing collectors tls certificate")
+		if err := c.Create(ctx, desired); err != nil {
+			return nil, err
+		}
+		return common.CollectorTLSFromSecret(desired)
+	}
+
+	logger.V(0).Info("Rotating collectors tls certificate")
+	existing.Data = data
+	if err := c.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return common.CollectorTLSFromSecret(existing)
+}
+
+// collectorTLSCA is a ca the autoscaler issued, along its private key
+type collectorTLSCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// renewCollectorTLS returns the secret data with the certificates rotated as needed, and whether it changed.
+// the ca is kept across rotations of the gateway certificate, so the trust bundle mounted in the node collectors stays valid.
+// before the ca expires, a new ca is added to the "ca.crt" trust bundle, and only after the grace period it signs the gateway certificate.
+// the old ca stays in the trust bundle until it expires.
+func renewCollectorTLS(current map[string][]byte, namespace string, now time.Time) (map[string][]byte, bool, error) {
+	data := make(map[string][]byte, len(current))
+	for key, value := range current {
+		data[key] = value
+	}
+
+	// the bundle may hold certificates that are no longer valid, they are dropped
+	var bundle []*x509.Certificate
+	certs, _ := parseCertificates(data[common.CollectorTLSCAKey])
+	for _, cert := range certs {
+		if now.Before(cert.NotAfter) {
+			bundle = append(bundle, cert)
+		}
+	}
+	changed := len(bundle) != len(certs)
+
+	signer := findCollectorTLSCA(bundle, data[collectorTLSCAPrivateKeyKey])
+	next := findCollectorTLSCA(bundle, data[collectorTLSNextCAPrivateKeyKey])
+	switch {
+	case next != nil && (signer == nil || !now.Before(next.cert.NotBefore.Add(collectorTLSCAGracePeriod)) ||
+		now.Add(collectorTLSRenewBefore).After(signer.cert.NotAfter)):
+		// the new ca was published in the trust bundle long enough, or the current ca can no longer be used
+		signer = next
+		delete(data, collectorTLSNextCAPrivateKeyKey)
+		changed = true
+	case signer == nil || now.Add(collectorTLSRenewBefore).After(signer.cert.NotAfter):
+		// there is no ca to keep (the secret is new, or was issued before the ca key was kept), or it expires before a new one was promoted.
+		// the new ca signs right away, and the node collectors trust it once they are restarted with the new bundle.
+		ca, err := issueCollectorTLSCA(now)
+		if err != nil {
+			return nil, false, err
+		}
+		signer = ca
+		bundle = append(bundle, ca.cert)
+		delete(data, collectorTLSNextCAPrivateKeyKey)
+		changed = true
+	case next == nil && now.Add(collectorTLSCARenewBefore).After(signer.cert.NotAfter):
+		// the new ca is only published in the trust bundle, the current ca keeps signing until the grace period is over
+		ca, err := issueCollectorTLSCA(now)
+		if err != nil {
+			return nil, false, err
+		}
+		bundle = append(bundle, ca.cert)
+		nextKeyPEM, err := encodeECPrivateKey(ca.key)
+		if err != nil {
+			return nil, false, err
+		}
+		data[collectorTLSNextCAPrivateKeyKey] = nextKeyPEM
+		changed = true
+	}
+
+	if collectorTLSNeedsRenewal(data[corev1.TLSCertKey], signer.cert, now) {
+		cert, key, err := issueCollectorTLSCertificate(signer, namespace, now)
+		if err != nil {
+			return nil, false, err
+		}
+		data[corev1.TLSCertKey] = cert
+		data[corev1.TLSPrivateKeyKey] = key
+		changed = true
+	}
+	if !changed {
+		return current, false, nil
+	}
+
+	caKeyPEM, err := encodeECPrivateKey(signer.key)
+	if err != nil {
+		return nil, false, err
+	}
+	data[collectorTLSCAPrivateKeyKey] = caKeyPEM
+
+	var bundlePEM []byte
+	for _, cert := range bundle {
+		bundlePEM = append(bundlePEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	data[common.CollectorTLSCAKey] = bundlePEM
+	return data, true, nil
+}
+
+// collectorTLSNeedsRenewal returns whether the gateway certificate is missing, expires soon, or is not signed by the ca
+func collectorTLSNeedsRenewal(certPEM []byte, ca *x509.Certificate, now time.Time) bool {
+	certs, err := parseCertificates(certPEM)
+	if err != nil || len(certs) == 0 {
+		return true
+	}
+	if certs[0].CheckSignatureFrom(ca) != nil {
+		return true
+	}
+	return now.Add(collectorTLSRenewBefore).After(certs[0].NotAfter)
+}
+
+// findCollectorTLSCA returns the ca in the bundle the private key belongs to, nil if there is none
+func findCollectorTLSCA(bundle []*x509.Certificate, keyPEM []byte) *collectorTLSCA {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	for _, cert := range bundle {
+		if key.PublicKey.Equal(cert.PublicKey) {
+			return &collectorTLSCA{cert: cert, key: key}
+		}
+	}
+	return nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func encodeECPrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// issueCollectorTLSCA creates a self-signed ca for the certificates of the gateway
+func issueCollectorTLSCA(now time.Time) (*collectorTLSCA, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: "odigos-collectors-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(collectorTLSCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+	return &collectorTLSCA{cert: cert, key: key}, nil
+}
+
+// issueCollectorTLSCertificate creates a certificate for the gateway service signed by the ca, it does not outlive the ca
+func issueCollectorTLSCertificate(ca *collectorTLSCA, namespace string, now time.Time) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	notAfter := now.Add(collectorTLSValidity)
+	if notAfter.After(ca.cert.NotAfter) {
+		notAfter = ca.cert.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: kubeObjectName},
+		DNSNames:     gatewayServiceDNSNames(namespace),
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPEM, err := encodeECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), keyPEM, nil
+}
+
+func gatewayServiceDNSNames(namespace string) []string {
+	return []string{
+		kubeObjectName,
+		fmt.Sprintf("%s.%s", kubeObjectName, namespace),
+		common.CollectorTLSServerName(namespace),
+		fmt.Sprintf("%s.cluster.local", common.CollectorTLSServerName(namespace)),
+	}
+}
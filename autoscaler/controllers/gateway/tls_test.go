@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/odigos-io/odigos/autoscaler/controllers/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testNamespace = "odigos-system"
+
+// verifyCollectorTLS verifies the gateway certificate the way the node collectors do, with the trust bundle they mount
+func verifyCollectorTLS(bundle []byte, data map[string][]byte, now time.Time) error {
+	if _, err := tls.X509KeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey]); err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(bundle)
+	certs, err := parseCertificates(data[corev1.TLSCertKey])
+	if err != nil {
+		return err
+	}
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:       roots,
+		DNSName:     common.CollectorTLSServerName(testNamespace),
+		CurrentTime: now,
+	})
+	return err
+}
+
+func TestRenewCollectorTLSIssues(t *testing.T) {
+	now := time.Now()
+	data, changed, err := renewCollectorTLS(nil, testNamespace, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEmpty(t, data[collectorTLSCAPrivateKeyKey])
+	assert.NotContains(t, data, collectorTLSNextCAPrivateKeyKey)
+	assert.NoError(t, verifyCollectorTLS(data[common.CollectorTLSCAKey], data, now))
+
+	renewed, changed, err := renewCollectorTLS(data, testNamespace, now.Add(CollectorTLSRenewCheckInterval))
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, data, renewed)
+}
+
+func TestRenewCollectorTLSKeepsCA(t *testing.T) {
+	now := time.Now()
+	data, _, err := renewCollectorTLS(nil, testNamespace, now)
+	require.NoError(t, err)
+	mountedBundle := data[common.CollectorTLSCAKey]
+
+	now = now.Add(collectorTLSValidity - collectorTLSRenewBefore + time.Hour)
+	renewed, changed, err := renewCollectorTLS(data, testNamespace, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEqual(t, data[corev1.TLSCertKey], renewed[corev1.TLSCertKey])
+	assert.Equal(t, mountedBundle, renewed[common.CollectorTLSCAKey])
+	assert.Equal(t, data[collectorTLSCAPrivateKeyKey], renewed[collectorTLSCAPrivateKeyKey])
+	assert.NoError(t, verifyCollectorTLS(mountedBundle, renewed, now))
+}
+
+func TestRenewCollectorTLSRotatesCA(t *testing.T) {
+	start := time.Now()
+	data, _, err := renewCollectorTLS(nil, testNamespace, start)
+	require.NoError(t, err)
+	firstCAKey := data[collectorTLSCAPrivateKeyKey]
+
+	// the node collectors mount a changed bundle only after they are rolled out, which is assumed to take up to a day
+	type publishedBundle struct {
+		at     time.Time
+		bundle []byte
+	}
+	published := []publishedBundle{{at: start, bundle: data[common.CollectorTLSCAKey]}}
+	mountedBundle := func(now time.Time) []byte {
+		mounted := published[0].bundle
+		for _, p := range published {
+			if !p.at.After(now.Add(-24 * time.Hour)) {
+				mounted = p.bundle
+			}
+		}
+		return mounted
+	}
+
+	sawBothCAs := false
+	for now := start; now.Before(start.Add(collectorTLSCAValidity + collectorTLSValidity)); now = now.Add(CollectorTLSRenewCheckInterval) {
+		renewed, _, err := renewCollectorTLS(data, testNamespace, now)
+		require.NoError(t, err)
+		if !bytes.Equal(renewed[common.CollectorTLSCAKey], data[common.CollectorTLSCAKey]) {
+			published = append(published, publishedBundle{at: now, bundle: renewed[common.CollectorTLSCAKey]})
+		}
+
+		if err := verifyCollectorTLS(mountedBundle(now), renewed, now); err != nil {
+			t.Fatalf("certificate issued after %s does not verify with the mounted trust bundle: %v", now.Sub(start), err)
+		}
+		bundle, err := parseCertificates(renewed[common.CollectorTLSCAKey])
+		require.NoError(t, err)
+		if len(bundle) == 2 {
+			sawBothCAs = true
+		}
+
+		data = renewed
+	}
+
+	assert.True(t, sawBothCAs)
+	assert.False(t, bytes.Equal(firstCAKey, data[collectorTLSCAPrivateKeyKey]))
+	bundle, err := parseCertificates(data[common.CollectorTLSCAKey])
+	require.NoError(t, err)
+	assert.Len(t, bundle, 1)
+}
+
+func TestRenewCollectorTLSWithoutCAKey(t *testing.T) {
+	now := time.Now()
+	data, _, err := renewCollectorTLS(nil, testNamespace, now)
+	require.NoError(t, err)
+	// secrets issued before the ca key was kept in the secret
+	delete(data, collectorTLSCAPrivateKeyKey)
+
+	renewed, changed, err := renewCollectorTLS(data, testNamespace, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotEmpty(t, renewed[collectorTLSCAPrivateKeyKey])
+	bundle, err := parseCertificates(renewed[common.CollectorTLSCAKey])
+	require.NoError(t, err)
+	assert.Len(t, bundle, 2)
+	assert.NoError(t, verifyCollectorTLS(renewed[common.CollectorTLSCAKey], renewed, now))
+}
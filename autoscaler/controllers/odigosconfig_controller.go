@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/autoscaler/controllers/datacollection"
 	"github.com/odigos-io/odigos/autoscaler/controllers/gateway"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -28,7 +29,14 @@ func (r *OdigosConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// the node collectors send to the gateway according to the collectors tls configuration
+	err = datacollection.Sync(ctx, r.Client, r.Scheme, r.ImagePullSecrets, r.OdigosVersion)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// the issued collectors tls certificate is renewed when the configuration is synced
+	return ctrl.Result{RequeueAfter: gateway.CollectorTLSRenewCheckInterval}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
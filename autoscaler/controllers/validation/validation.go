@@ -0,0 +1,225 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/odigos-io/odigos/common/config"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/otelcol"
+	"gopkg.in/yaml.v3"
+	odigoscol "odigos.io/opentelemetry-collector/cmd/odigosotelcol/components"
+)
+
+var (
+	factoriesOnce sync.Once
+	factories     otelcol.Factories
+	factoriesErr  error
+)
+
+// the components built into the odigos collector, created once as building them is expensive
+func getFactories() (otelcol.Factories, error) {
+	factoriesOnce.Do(func() {
+		factories, factoriesErr = odigoscol.Components()
+	})
+	return factories, factoriesErr
+}
+
+// InvalidComponentError is the error of a component the collector would fail to load
+type InvalidComponentError struct {
+	// the kind of the component, e.g. exporter
+	Kind string
+	// the name of the component in the config, e.g. otlp/my-destination
+	Name string
+	Err  error
+}
+
+func (e *InvalidComponentError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Kind, e.Name, e.Err)
+}
+
+func (e *InvalidComponentError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorReason is the condition reason for a resource that failed to be added to the collector config.
+// it tells apart resources rejected by the collector from those that failed to be transformed to its config, which get defaultReason.
+func ErrorReason(err error, defaultReason string) string {
+	var invalidErr *InvalidComponentError
+	if errors.As(err, &invalidErr) {
+		return "ErrInvalidOtelcolConfig"
+	}
+	return defaultReason
+}
+
+// ValidateConfig checks a collector config against the components built into the odigos collector,
+// the same way the collector does when it loads it: the config of each component is unmarshalled by its factory and validated,
+// and the pipelines and extensions of the service must reference configured components.
+// errors of components are returned keyed by config.ComponentKey, other errors are returned as the error.
+func ValidateConfig(data string) (map[string]error, error) {
+	factories, err := getFactories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the collector factories: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse collector config: %w", err)
+	}
+	conf := confmap.NewFromStringMap(raw)
+
+	sections := map[string]func(component.Type) component.Factory{
+		"receivers": func(t component.Type) component.Factory {
+			if f, ok := factories.Receivers[t]; ok {
+				return f
+			}
+			return nil
+		},
+		"processors": func(t component.Type) component.Factory {
+			if f, ok := factories.Processors[t]; ok {
+				return f
+			}
+			return nil
+		},
+		"exporters": func(t component.Type) component.Factory {
+			if f, ok := factories.Exporters[t]; ok {
+				return f
+			}
+			return nil
+		},
+		"connectors": func(t component.Type) component.Factory {
+			if f, ok := factories.Connectors[t]; ok {
+				return f
+			}
+			return nil
+		},
+		"extensions": func(t component.Type) component.Factory {
+			if f, ok := factories.Extensions[t]; ok {
+				return f
+			}
+			return nil
+		},
+	}
+
+	componentErrs := map[string]error{}
+	configured := map[string]bool{}
+	for section, getFactory := range sections {
+		sectionConf, err := conf.Sub(section)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", section, err)
+		}
+
+		for name := range sectionConf.ToStringMap() {
+			key := config.ComponentKey(section, name)
+			configured[key] = true
+			if err := validateComponent(sectionConf, name, getFactory); err != nil {
+				componentErrs[key] = &InvalidComponentError{Kind: strings.TrimSuffix(section, "s"), Name: name, Err: err}
+			}
+		}
+	}
+
+	return componentErrs, validateService(conf, configured)
+}
+
+func validateComponent(sectionConf *confmap.Conf, name string, getFactory func(component.Type) component.Factory) error {
+	var id component.ID
+	if err := id.UnmarshalText([]byte(name)); err != nil {
+		return err
+	}
+
+	factory := getFactory(id.Type())
+	if factory == nil {
+		return fmt.Errorf("%s is not supported by the odigos collector", id.Type())
+	}
+
+	componentConf, err := sectionConf.Sub(name)
+	if err != nil {
+		return err
+	}
+	cfg := factory.CreateDefaultConfig()
+	if err := component.UnmarshalConfig(componentConf, cfg); err != nil {
+		return err
+	}
+	return component.ValidateConfig(cfg)
+}
+
+// servicePipeline is the config of a pipeline, config.Pipeline cannot be used as confmap matches the field names case sensitively
+type servicePipeline struct {
+	Receivers  []string `mapstructure:"receivers"`
+	Processors []string `mapstructure:"processors"`
+	Exporters  []string `mapstructure:"exporters"`
+}
+
+func validateService(conf *confmap.Conf, configured map[string]bool) error {
+	var service struct {
+		Extensions []string                   `mapstructure:"extensions"`
+		Pipelines  map[string]servicePipeline `mapstructure:"pipelines"`
+	}
+	serviceConf, err := conf.Sub("service")
+	if err != nil {
+		return err
+	}
+	if err := serviceConf.Unmarshal(&service, confmap.WithIgnoreUnused()); err != nil {
+		return fmt.Errorf("failed to read the service config: %w", err)
+	}
+
+	var errs []error
+	for _, extension := range service.Extensions {
+		if !configured[config.ComponentKey("extensions", extension)] {
+			errs = append(errs, fmt.Errorf("service references extension %q which is not configured", extension))
+		}
+	}
+
+	pipelineNames := make([]string, 0, len(service.Pipelines))
+	for name := range service.Pipelines {
+		pipelineNames = append(pipelineNames, name)
+	}
+	sort.Strings(pipelineNames)
+	for _, name := range pipelineNames {
+		pipeline := service.Pipelines[name]
+		if len(pipeline.Receivers) == 0 || len(pipeline.Exporters) == 0 {
+			errs = append(errs, fmt.Errorf("pipeline %q must have at least one receiver and one exporter", name))
+		}
+		// connectors are used as exporters of one pipeline and receivers of another
+		for _, receiver := range pipeline.Receivers {
+			if !configured[config.ComponentKey("receivers", receiver)] && !configured[config.ComponentKey("connectors", receiver)] {
+				errs = append(errs, fmt.Errorf("pipeline %q references receiver %q which is not configured", name, receiver))
+			}
+		}
+		for _, processor := range pipeline.Processors {
+			if !configured[config.ComponentKey("processors", processor)] {
+				errs = append(errs, fmt.Errorf("pipeline %q references processor %q which is not configured", name, processor))
+			}
+		}
+		for _, exporter := range pipeline.Exporters {
+			if !configured[config.ComponentKey("exporters", exporter)] && !configured[config.ComponentKey("connectors", exporter)] {
+				errs = append(errs, fmt.Errorf("pipeline %q references exporter %q which is not configured", name, exporter))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateConfigWithStatus validates the collector config, and reports the errors of components
+// on the destinations and processors that added them in the status.
+// a non nil error means the config should not be rolled out.
+func ValidateConfigWithStatus(data string, status *config.ResourceStatuses) error {
+	componentErrs, err := ValidateConfig(data)
+	status.RecordComponentErrors(componentErrs)
+
+	keys := make([]string, 0, len(componentErrs))
+	for key := range componentErrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	errs := make([]error, 0, len(keys)+1)
+	for _, key := range keys {
+		errs = append(errs, componentErrs[key])
+	}
+	return errors.Join(append(errs, err)...)
+}
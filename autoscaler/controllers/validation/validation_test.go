@@ -0,0 +1,276 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/odigos-io/odigos/common/config"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		// the keys of the components expected to be invalid
+		invalidComponents []string
+		expectedErr       string
+	}{
+		{
+			name: "valid config",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+processors:
+  batch: {}
+exporters:
+  otlp/my-destination:
+    endpoint: collector.internal:4317
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [otlp/my-destination]
+`,
+		},
+		{
+			name: "exporter without endpoint",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+exporters:
+  otlp/my-destination: {}
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [otlp/my-destination]
+`,
+			invalidComponents: []string{config.ComponentKey("exporters", "otlp/my-destination")},
+		},
+		{
+			name: "exporter with unknown field",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+exporters:
+  otlp/my-destination:
+    endpoint: collector.internal:4317
+    no_such_field: true
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [otlp/my-destination]
+`,
+			invalidComponents: []string{config.ComponentKey("exporters", "otlp/my-destination")},
+		},
+		{
+			name: "exporter not built into the collector",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+exporters:
+  nosuchexporter: {}
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [nosuchexporter]
+`,
+			invalidComponents: []string{config.ComponentKey("exporters", "nosuchexporter")},
+		},
+		{
+			name: "undefined processor in pipeline",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+exporters:
+  debug: {}
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      processors: [batch]
+      exporters: [debug]
+`,
+			expectedErr: `pipeline "traces" references processor "batch" which is not configured`,
+		},
+		{
+			name: "connector used as receiver and exporter",
+			data: `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+connectors:
+  forward/my-destination: {}
+exporters:
+  debug: {}
+service:
+  pipelines:
+    traces/in:
+      receivers: [otlp]
+      exporters: [forward/my-destination]
+    traces/out:
+      receivers: [forward/my-destination]
+      exporters: [debug]
+`,
+		},
+		{
+			name:        "invalid yaml",
+			data:        "receivers: [",
+			expectedErr: "failed to parse collector config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			componentErrs, err := ValidateConfig(tt.data)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			invalidComponents := make([]string, 0, len(componentErrs))
+			for key, componentErr := range componentErrs {
+				invalidComponents = append(invalidComponents, key)
+				assert.Equal(t, "ErrInvalidOtelcolConfig", ErrorReason(componentErr, "ErrConfigDestination"))
+			}
+			assert.ElementsMatch(t, tt.invalidComponents, invalidComponents)
+		})
+	}
+}
+
+func TestValidateService(t *testing.T) {
+	configured := map[string]bool{
+		config.ComponentKey("receivers", "otlp"):          true,
+		config.ComponentKey("processors", "batch"):        true,
+		config.ComponentKey("exporters", "debug"):         true,
+		config.ComponentKey("connectors", "forward"):      true,
+		config.ComponentKey("extensions", "health_check"): true,
+	}
+
+	tests := []struct {
+		name        string
+		service     map[string]any
+		expectedErr []string
+	}{
+		{
+			name: "valid pipeline",
+			service: map[string]any{
+				"extensions": []any{"health_check"},
+				"pipelines": map[string]any{
+					"traces": map[string]any{
+						"receivers":  []any{"otlp"},
+						"processors": []any{"batch"},
+						"exporters":  []any{"debug"},
+					},
+				},
+			},
+		},
+		{
+			name: "connector used as receiver and exporter",
+			service: map[string]any{
+				"pipelines": map[string]any{
+					"traces/in": map[string]any{
+						"receivers": []any{"otlp"},
+						"exporters": []any{"forward"},
+					},
+					"traces/out": map[string]any{
+						"receivers": []any{"forward"},
+						"exporters": []any{"debug"},
+					},
+				},
+			},
+		},
+		{
+			name: "undefined components",
+			service: map[string]any{
+				"extensions": []any{"pprof"},
+				"pipelines": map[string]any{
+					"traces": map[string]any{
+						"receivers":  []any{"jaeger"},
+						"processors": []any{"batch", "filter"},
+						"exporters":  []any{"otlp"},
+					},
+				},
+			},
+			expectedErr: []string{
+				`service references extension "pprof" which is not configured`,
+				`pipeline "traces" references receiver "jaeger" which is not configured`,
+				`pipeline "traces" references processor "filter" which is not configured`,
+				`pipeline "traces" references exporter "otlp" which is not configured`,
+			},
+		},
+		{
+			name: "pipeline without exporters",
+			service: map[string]any{
+				"pipelines": map[string]any{
+					"logs": map[string]any{
+						"receivers": []any{"otlp"},
+					},
+				},
+			},
+			expectedErr: []string{`pipeline "logs" must have at least one receiver and one exporter`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := confmap.NewFromStringMap(map[string]any{"service": tt.service})
+			err := validateService(conf, configured)
+			if len(tt.expectedErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expectedErr := range tt.expectedErr {
+				assert.ErrorContains(t, err, expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateConfigWithStatus(t *testing.T) {
+	data := `
+receivers:
+  otlp:
+    protocols:
+      grpc: {}
+exporters:
+  otlp/my-destination: {}
+  debug: {}
+service:
+  pipelines:
+    traces:
+      receivers: [otlp]
+      exporters: [otlp/my-destination, debug]
+`
+	status := &config.ResourceStatuses{
+		Destination: map[string]error{},
+		Processor:   map[string]error{},
+		DestinationComponents: map[string]string{
+			config.ComponentKey("exporters", "otlp/my-destination"): "my-destination",
+		},
+	}
+
+	err := ValidateConfigWithStatus(data, status)
+	assert.ErrorContains(t, err, `invalid exporter "otlp/my-destination"`)
+	assert.ErrorContains(t, status.Destination["my-destination"], `invalid exporter "otlp/my-destination"`)
+
+	var invalidErr *InvalidComponentError
+	assert.True(t, errors.As(status.Destination["my-destination"], &invalidErr))
+}
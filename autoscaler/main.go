@@ -132,6 +132,10 @@ func main() {
 					Label: labels.Set(gateway.CommonLabels).AsSelector(),
 					Field: nsSelector,
 				},
+				&appsv1.StatefulSet{}: {
+					Label: labels.Set(gateway.CommonLabels).AsSelector(),
+					Field: nsSelector,
+				},
 				&corev1.Service{}: {
 					Label: labels.Set(gateway.CommonLabels).AsSelector(),
 					Field: nsSelector,
@@ -142,6 +146,9 @@ func main() {
 				&corev1.ConfigMap{}: {
 					Field: nsSelector,
 				},
+				&corev1.Secret{}: {
+					Field: nsSelector,
+				},
 			},
 		},
 		HealthProbeBindAddress: probeAddr,
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/odigos-io/odigos/cli/pkg/log"
+	"github.com/odigos-io/odigos/cli/pkg/replay"
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/spf13/cobra"
+)
+
+const (
+	replayEndpointFlag = "endpoint"
+	replayFromFlag     = "from"
+	replayToFlag       = "to"
+	replayServiceFlag  = "service"
+	replayDryRunFlag   = "dry-run"
+
+	replayCAFileFlag             = "ca-file"
+	replayTLSServerNameFlag      = "tls-server-name"
+	replayInsecureSkipVerifyFlag = "insecure-skip-verify"
+)
+
+// replayCmd represents the replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay <source>",
+	Short: "Re-ingest telemetry archived by the odigos storage destinations",
+	Long: `Re-ingest traces and logs archived by the odigos storage destinations (AWS S3, Google Cloud Storage and Azure Blob Storage).
+
+The source is either a local directory, or a bucket path (gs://<bucket>/<prefix>, s3://<bucket>/<prefix> or azblob://<account>/<container>/<prefix>)
+which is downloaded first using the gcloud, aws or az command line tools and their configured credentials.
+Objects written with the otlp_json and otlp_proto marshalers are sent over OTLP/HTTP to the endpoint, which defaults to a port-forwarded odigos gateway:
+
+	kubectl port-forward -n odigos-system svc/odigos-gateway 4318
+
+When TLS is enabled for the collectors, the gateway only accepts https. Use an https endpoint and trust the certificate authority of the gateway with --ca-file.
+The certificate is issued for the gateway service, so verify that name with --tls-server-name when the gateway is port-forwarded:
+
+	kubectl get secret -n odigos-system odigos-gateway-tls -o jsonpath='{.data.ca\.crt}' | base64 -d > gateway-ca.crt
+	odigos replay ./archive --endpoint https://localhost:4318 --ca-file gateway-ca.crt --tls-server-name odigos-gateway.odigos-system.svc`,
+	Example: `
+# Replay the traces and logs of the frontend service of an incident to the odigos gateway
+odigos replay gs://my-archive/traces --from 2024-03-01T10:00:00Z --to 2024-03-01T11:00:00Z --service frontend
+
+# Backfill a new backend from a local copy of the archive
+odigos replay ./archive --endpoint http://otel-collector.observability:4318
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		source := args[0]
+
+		filter, err := replayFilter(cmd)
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m %s\n", err)
+			os.Exit(1)
+		}
+
+		dir := source
+		if replay.IsBucketPath(source) {
+			dir, err = os.MkdirTemp("", "odigos-replay-")
+			if err != nil {
+				fmt.Printf("\033[31mERROR\033[0m Cannot create a temporary directory: %s\n", err)
+				os.Exit(1)
+			}
+			defer os.RemoveAll(dir)
+
+			l := log.Print(fmt.Sprintf("Downloading %s ...", source))
+			if err := replay.Download(ctx, source, dir); err != nil {
+				os.RemoveAll(dir)
+				l.Error(err)
+			}
+			l.Success()
+		}
+
+		endpoint := cmd.Flag(replayEndpointFlag).Value.String()
+		dryRun, _ := cmd.Flags().GetBool(replayDryRunFlag)
+		insecureSkipVerify, _ := cmd.Flags().GetBool(replayInsecureSkipVerifyFlag)
+		summary, err := replay.Run(ctx, dir, replay.Options{
+			Endpoint: endpoint,
+			Filter:   *filter,
+			DryRun:   dryRun,
+			TLS: replay.TLSOptions{
+				CAFile:             cmd.Flag(replayCAFileFlag).Value.String(),
+				ServerName:         cmd.Flag(replayTLSServerNameFlag).Value.String(),
+				InsecureSkipVerify: insecureSkipVerify,
+			},
+		})
+		if summary != nil {
+			action := "Replayed"
+			if dryRun {
+				action = "Found"
+			}
+			fmt.Printf("%s %d spans and %d log records from %d objects (%d other files skipped)\n",
+				action, summary.Spans, summary.LogRecords, summary.Objects, summary.SkippedObjects)
+		}
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m %s\n", err)
+			if replay.IsBucketPath(source) {
+				os.RemoveAll(dir)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+func replayFilter(cmd *cobra.Command) (*replay.Filter, error) {
+	filter := &replay.Filter{}
+
+	var err error
+	if from := cmd.Flag(replayFromFlag).Value.String(); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return nil, fmt.Errorf("invalid --%s, expected an RFC3339 time: %w", replayFromFlag, err)
+		}
+	}
+	if to := cmd.Flag(replayToFlag).Value.String(); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return nil, fmt.Errorf("invalid --%s, expected an RFC3339 time: %w", replayToFlag, err)
+		}
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, fmt.Errorf("--%s must be before --%s", replayFromFlag, replayToFlag)
+	}
+
+	filter.Services, err = cmd.Flags().GetStringSlice(replayServiceFlag)
+	return filter, err
+}
+
+func init() {
+	rootCmd.AddCommand(replayCmd)
+
+	replayCmd.Flags().String(replayEndpointFlag, fmt.Sprintf("http://localhost:%d", consts.OTLPHttpPort), "OTLP/HTTP endpoint to send the telemetry to")
+	replayCmd.Flags().String(replayFromFlag, "", "replay only telemetry at or after this time (RFC3339)")
+	replayCmd.Flags().String(replayToFlag, "", "replay only telemetry before this time (RFC3339)")
+	replayCmd.Flags().StringSlice(replayServiceFlag, nil, "replay only telemetry of these services, can be repeated")
+	replayCmd.Flags().Bool(replayDryRunFlag, false, "count the telemetry that would be replayed without sending it")
+	replayCmd.Flags().String(replayCAFileFlag, "", "pem file of the certificate authority of an https endpoint, e.g. the ca.crt of the odigos-gateway-tls secret")
+	replayCmd.Flags().String(replayTLSServerNameFlag, "", "name verified in the certificate of an https endpoint instead of its host")
+	replayCmd.Flags().Bool(replayInsecureSkipVerifyFlag, false, "do not verify the certificate of an https endpoint")
+}
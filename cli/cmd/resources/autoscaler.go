@@ -75,6 +75,18 @@ func NewAutoscalerRole(ns string) *rbacv1.Role {
 				APIGroups: []string{""},
 				Resources: []string{"services"},
 			},
+			{
+				Verbs: []string{
+					"create",
+					"get",
+					"list",
+					"patch",
+					"update",
+					"watch",
+				},
+				APIGroups: []string{""},
+				Resources: []string{"secrets"},
+			},
 			{
 				Verbs: []string{
 					"create",
@@ -119,6 +131,19 @@ func NewAutoscalerRole(ns string) *rbacv1.Role {
 				APIGroups: []string{"apps"},
 				Resources: []string{"deployments/status"},
 			},
+			{
+				Verbs: []string{
+					"create",
+					"delete",
+					"get",
+					"list",
+					"patch",
+					"update",
+					"watch",
+				},
+				APIGroups: []string{"apps"},
+				Resources: []string{"statefulsets"},
+			},
 			{
 				Verbs: []string{
 					"create",
@@ -203,6 +228,15 @@ func NewAutoscalerClusterRole() *rbacv1.ClusterRole {
 				APIGroups: []string{"apps"},
 				Resources: []string{"deployments"},
 			},
+			{
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+				APIGroups: []string{"apps"},
+				Resources: []string{"statefulsets"},
+			},
 			{
 				Verbs: []string{
 					"create",
@@ -281,6 +315,7 @@ func NewAutoscalerClusterRole() *rbacv1.ClusterRole {
 					"patch",
 					"create",
 					"update",
+					"delete",
 				},
 				APIGroups: []string{"odigos.io"},
 				Resources: []string{"processors"},
@@ -301,6 +336,15 @@ func NewAutoscalerClusterRole() *rbacv1.ClusterRole {
 				APIGroups: []string{"odigos.io"},
 				Resources: []string{"destinations/status"},
 			},
+			{
+				Verbs: []string{
+					"get",
+					"patch",
+					"update",
+				},
+				APIGroups: []string{"odigos.io"},
+				Resources: []string{"processors/status"},
+			},
 			{
 				Verbs: []string{
 					"watch",
@@ -308,7 +352,7 @@ func NewAutoscalerClusterRole() *rbacv1.ClusterRole {
 					"list",
 				},
 				APIGroups: []string{"actions.odigos.io"},
-				Resources: []string{"addclusterinfos", "deleteattributes", "renameattributes", "probabilisticsamplers", "latencysamplers", "errorsamplers"},
+				Resources: []string{"addclusterinfos", "deleteattributes", "renameattributes", "probabilisticsamplers", "latencysamplers", "errorsamplers", "servicenamesamplers", "spanattributesamplers", "adaptivesamplers"},
 			},
 			{
 				Verbs: []string{
@@ -317,7 +361,7 @@ func NewAutoscalerClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 				APIGroups: []string{"actions.odigos.io"},
-				Resources: []string{"addclusterinfos/status", "deleteattributes/status", "renameattributes/status", "probabilisticsamplers/status", "latencysamplers/status", "errorsamplers/status"},
+				Resources: []string{"addclusterinfos/status", "deleteattributes/status", "renameattributes/status", "probabilisticsamplers/status", "latencysamplers/status", "errorsamplers/status", "servicenamesamplers/status", "spanattributesamplers/status", "adaptivesamplers/status"},
 			},
 			{
 				Verbs: []string{
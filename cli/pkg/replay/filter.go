@@ -0,0 +1,84 @@
+package replay
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const serviceNameAttribute = "service.name"
+
+// Filter selects the telemetry to replay, zero values select everything
+type Filter struct {
+	// From and To bound the time range, spans are matched by their start time and log records by their timestamp
+	From time.Time
+	To   time.Time
+	// Services holds the service names to replay
+	Services []string
+}
+
+func (f *Filter) matchesTime(ts pcommon.Timestamp) bool {
+	t := ts.AsTime()
+	if !f.From.IsZero() && t.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !t.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+func (f *Filter) matchesResource(resource pcommon.Resource) bool {
+	if len(f.Services) == 0 {
+		return true
+	}
+
+	serviceName, ok := resource.Attributes().Get(serviceNameAttribute)
+	if !ok {
+		return false
+	}
+	for _, service := range f.Services {
+		if serviceName.Str() == service {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTraces removes the spans not selected by the filter, along with the resources and scopes left empty
+func (f *Filter) filterTraces(td ptrace.Traces) {
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		if !f.matchesResource(rs.Resource()) {
+			return true
+		}
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(span ptrace.Span) bool {
+				return !f.matchesTime(span.StartTimestamp())
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+// filterLogs removes the log records not selected by the filter, along with the resources and scopes left empty
+func (f *Filter) filterLogs(ld plog.Logs) {
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		if !f.matchesResource(rl.Resource()) {
+			return true
+		}
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(logRecord plog.LogRecord) bool {
+				ts := logRecord.Timestamp()
+				if ts == 0 {
+					ts = logRecord.ObservedTimestamp()
+				}
+				return !f.matchesTime(ts)
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
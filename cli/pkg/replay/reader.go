@@ -0,0 +1,145 @@
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// objectNamePattern matches the names of objects written by the storage exporters: <file_prefix><signal>_<id>.<format>
+// with an optional compression extension. binpb is the extension of the otlp_proto marshaler of the S3 exporter.
+var objectNamePattern = regexp.MustCompile(`(traces|logs|metrics)_[0-9]+\.(json|proto|binpb)(\.gz|\.zst)?$`)
+
+// the first bytes of gzip and zstd streams, which neither json nor otlp proto objects start with
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// object is an archived object found in the source directory
+type object struct {
+	path   string
+	signal string
+	format string
+}
+
+// findObjects walks the directory and returns the objects written by the storage exporters, in lexical order
+// which is also the time order of the partitioned keys
+func findObjects(dir string) ([]object, int, error) {
+	var objects []object
+	skipped := 0
+	err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		match := objectNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			skipped++
+			return nil
+		}
+		objects = append(objects, object{path: path, signal: match[1], format: match[2]})
+		return nil
+	})
+	return objects, skipped, err
+}
+
+// readObject returns the decompressed content of the object.
+// the compression is detected from the content rather than the name, as objects uploaded with a content encoding
+// can be downloaded already decompressed under their compressed name.
+func readObject(obj object) ([]byte, error) {
+	data, err := os.ReadFile(obj.path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(data, gzipMagic):
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return io.ReadAll(reader)
+	case bytes.HasPrefix(data, zstdMagic):
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		return decoder.DecodeAll(data, nil)
+	default:
+		return data, nil
+	}
+}
+
+// decodeTraces returns the batches held in a traces object. json objects may hold a batch per line.
+func decodeTraces(obj object, data []byte) ([]ptrace.Traces, error) {
+	if obj.format != "json" {
+		td, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", obj.path, err)
+		}
+		return []ptrace.Traces{td}, nil
+	}
+
+	var batches []ptrace.Traces
+	err := forEachLine(data, func(line []byte) error {
+		td, err := (&ptrace.JSONUnmarshaler{}).UnmarshalTraces(line)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", obj.path, err)
+		}
+		batches = append(batches, td)
+		return nil
+	})
+	return batches, err
+}
+
+// decodeLogs returns the batches held in a logs object. json objects may hold a batch per line.
+func decodeLogs(obj object, data []byte) ([]plog.Logs, error) {
+	if obj.format != "json" {
+		ld, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", obj.path, err)
+		}
+		return []plog.Logs{ld}, nil
+	}
+
+	var batches []plog.Logs
+	err := forEachLine(data, func(line []byte) error {
+		ld, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(line)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", obj.path, err)
+		}
+		batches = append(batches, ld)
+		return nil
+	})
+	return batches, err
+}
+
+func forEachLine(data []byte, fn func(line []byte) error) error {
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if fnErr := fn(line); fnErr != nil {
+				return fnErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Package replay re-ingests telemetry archived by the odigos storage destinations, by sending it over OTLP.
+package replay
+
+import (
+	"context"
+	"fmt"
+)
+
+type Options struct {
+	// Endpoint is the OTLP/HTTP endpoint the telemetry is sent to
+	Endpoint string
+	Filter   Filter
+	// DryRun counts the telemetry that would be sent without sending it
+	DryRun bool
+	TLS    TLSOptions
+}
+
+// TLSOptions configures how the certificate of an https endpoint is verified
+type TLSOptions struct {
+	// CAFile is a pem file of the certificate authorities trusted in addition to the system ones
+	CAFile string
+	// ServerName is the name verified in the certificate instead of the host of the endpoint,
+	// e.g. the name of the gateway service when it is port-forwarded to localhost
+	ServerName string
+	// InsecureSkipVerify sends the telemetry without verifying the certificate
+	InsecureSkipVerify bool
+}
+
+type Summary struct {
+	// Objects is the number of archived objects read
+	Objects int
+	// SkippedObjects is the number of files that are not traces or logs written by the storage exporters
+	SkippedObjects int
+	Spans          int
+	LogRecords     int
+}
+
+// Run replays the traces and logs archived under the directory, in the order of their object keys.
+// It stops on the first object that fails to be read or sent, the summary holds what was replayed until then.
+func Run(ctx context.Context, dir string, opts Options) (*Summary, error) {
+	objects, skipped, err := findObjects(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+
+	sender, err := newOTLPSender(opts.Endpoint, opts.TLS)
+	if err != nil {
+		return nil, err
+	}
+	summary := &Summary{SkippedObjects: skipped}
+	for _, obj := range objects {
+		if obj.signal == "metrics" {
+			summary.SkippedObjects++
+			continue
+		}
+
+		data, err := readObject(obj)
+		if err != nil {
+			return summary, fmt.Errorf("failed to read %s: %w", obj.path, err)
+		}
+		summary.Objects++
+
+		switch obj.signal {
+		case "traces":
+			batches, err := decodeTraces(obj, data)
+			if err != nil {
+				return summary, err
+			}
+			for _, td := range batches {
+				opts.Filter.filterTraces(td)
+				if td.SpanCount() == 0 {
+					continue
+				}
+				if !opts.DryRun {
+					if err := sender.sendTraces(ctx, td); err != nil {
+						return summary, fmt.Errorf("failed to send traces of %s: %w", obj.path, err)
+					}
+				}
+				summary.Spans += td.SpanCount()
+			}
+		case "logs":
+			batches, err := decodeLogs(obj, data)
+			if err != nil {
+				return summary, err
+			}
+			for _, ld := range batches {
+				opts.Filter.filterLogs(ld)
+				if ld.LogRecordCount() == 0 {
+					continue
+				}
+				if !opts.DryRun {
+					if err := sender.sendLogs(ctx, ld); err != nil {
+						return summary, fmt.Errorf("failed to send logs of %s: %w", obj.path, err)
+					}
+				}
+				summary.LogRecords += ld.LogRecordCount()
+			}
+		}
+	}
+	return summary, nil
+}
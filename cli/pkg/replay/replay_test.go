@@ -0,0 +1,287 @@
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+var replayStart = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+type receivedTelemetry struct {
+	mu         sync.Mutex
+	spans      int
+	logRecords int
+}
+
+func newTestReceiver(t *testing.T, received *receivedTelemetry) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request: %s", err)
+		}
+
+		received.mu.Lock()
+		defer received.mu.Unlock()
+		switch r.URL.Path {
+		case "/v1/traces":
+			req := ptraceotlp.NewExportRequest()
+			if err := req.UnmarshalProto(body); err != nil {
+				t.Errorf("failed to decode traces: %s", err)
+			}
+			received.spans += req.Traces().SpanCount()
+		case "/v1/logs":
+			req := plogotlp.NewExportRequest()
+			if err := req.UnmarshalProto(body); err != nil {
+				t.Errorf("failed to decode logs: %s", err)
+			}
+			received.logRecords += req.Logs().LogRecordCount()
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestTraces(service string, start time.Time) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr(serviceNameAttribute, service)
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	return td
+}
+
+func newTestLogs(service string, timestamp time.Time) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr(serviceNameAttribute, service)
+	rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	return ld
+}
+
+func writeTestFile(t *testing.T, path string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunReplaysFilteredObjects(t *testing.T) {
+	dir := t.TempDir()
+
+	// a json object with a batch per line
+	var tracesJSON bytes.Buffer
+	for _, td := range []ptrace.Traces{
+		newTestTraces("frontend", replayStart),
+		newTestTraces("cart", replayStart),
+		newTestTraces("frontend", replayStart.Add(2*time.Hour)),
+	} {
+		line, err := (&ptrace.JSONMarshaler{}).MarshalTraces(td)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tracesJSON.Write(line)
+		tracesJSON.WriteByte('\n')
+	}
+	writeTestFile(t, filepath.Join(dir, "year=2024/month=03/day=01/traces_123456789.json"), tracesJSON.Bytes())
+
+	// a gzip compressed proto object
+	logsProto, err := (&plog.ProtoMarshaler{}).MarshalLogs(newTestLogs("frontend", replayStart.Add(time.Minute)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var logsGzip bytes.Buffer
+	gz := gzip.NewWriter(&logsGzip)
+	gz.Write(logsProto)
+	gz.Close()
+	writeTestFile(t, filepath.Join(dir, "year=2024/month=03/day=01/logs_987654321.proto.gz"), logsGzip.Bytes())
+
+	writeTestFile(t, filepath.Join(dir, "year=2024/month=03/day=01/metrics_123456789.json"), []byte("{}"))
+	writeTestFile(t, filepath.Join(dir, "README.md"), []byte("archive"))
+
+	received := &receivedTelemetry{}
+	server := newTestReceiver(t, received)
+	defer server.Close()
+
+	summary, err := Run(context.Background(), dir, Options{
+		Endpoint: server.URL,
+		Filter: Filter{
+			From:     replayStart,
+			To:       replayStart.Add(time.Hour),
+			Services: []string{"frontend"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("replay failed: %s", err)
+	}
+
+	if summary.Objects != 2 || summary.SkippedObjects != 2 {
+		t.Errorf("expected 2 objects read and 2 skipped, got %d and %d", summary.Objects, summary.SkippedObjects)
+	}
+	if summary.Spans != 1 || received.spans != 1 {
+		t.Errorf("expected 1 span replayed, got %d and %d received", summary.Spans, received.spans)
+	}
+	if summary.LogRecords != 1 || received.logRecords != 1 {
+		t.Errorf("expected 1 log record replayed, got %d and %d received", summary.LogRecords, received.logRecords)
+	}
+}
+
+func TestRunDryRunDoesNotSend(t *testing.T) {
+	dir := t.TempDir()
+	tracesProto, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(newTestTraces("frontend", replayStart))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dir, "traces_123456789.binpb"), tracesProto)
+
+	received := &receivedTelemetry{}
+	server := newTestReceiver(t, received)
+	defer server.Close()
+
+	summary, err := Run(context.Background(), dir, Options{Endpoint: server.URL, DryRun: true})
+	if err != nil {
+		t.Fatalf("replay failed: %s", err)
+	}
+	if summary.Spans != 1 || received.spans != 0 {
+		t.Errorf("expected 1 span found and none sent, got %d and %d received", summary.Spans, received.spans)
+	}
+}
+
+func TestReadObjectDetectsCompressionFromContent(t *testing.T) {
+	// a json object with a batch per line, and a blank line
+	var tracesJSON bytes.Buffer
+	for i, service := range []string{"frontend", "cart", "checkout"} {
+		line, err := (&ptrace.JSONMarshaler{}).MarshalTraces(newTestTraces(service, replayStart))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tracesJSON.Write(line)
+		tracesJSON.WriteByte('\n')
+		if i == 0 {
+			tracesJSON.WriteByte('\n')
+		}
+	}
+
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write(tracesJSON.Bytes())
+	gz.Close()
+
+	encoder, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zstded := encoder.EncodeAll(tracesJSON.Bytes(), nil)
+	encoder.Close()
+
+	tests := []struct {
+		name string
+		file string
+		data []byte
+	}{
+		{name: "gzip", file: "traces_1.json.gz", data: gzipped.Bytes()},
+		{name: "zstd", file: "traces_1.json.zst", data: zstded},
+		// gcs decompressive transcoding serves objects uploaded with a gzip content encoding decompressed
+		{name: "transcoded gzip", file: "traces_1.json.gz", data: tracesJSON.Bytes()},
+		{name: "uncompressed", file: "traces_1.json", data: tracesJSON.Bytes()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTestFile(t, filepath.Join(dir, tt.file), tt.data)
+
+			objects, _, err := findObjects(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(objects) != 1 {
+				t.Fatalf("expected 1 object, got %d", len(objects))
+			}
+
+			data, err := readObject(objects[0])
+			if err != nil {
+				t.Fatalf("failed to read object: %s", err)
+			}
+			batches, err := decodeTraces(objects[0], data)
+			if err != nil {
+				t.Fatalf("failed to decode object: %s", err)
+			}
+			if len(batches) != 3 {
+				t.Fatalf("expected 3 batches, got %d", len(batches))
+			}
+			service, _ := batches[2].ResourceSpans().At(0).Resource().Attributes().Get(serviceNameAttribute)
+			if service.Str() != "checkout" {
+				t.Errorf("expected the last batch of checkout, got %s", service.Str())
+			}
+		})
+	}
+}
+
+func TestRunVerifiesTLSEndpoint(t *testing.T) {
+	dir := t.TempDir()
+	tracesProto, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(newTestTraces("frontend", replayStart))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dir, "traces_123456789.binpb"), tracesProto)
+
+	received := &receivedTelemetry{}
+	// the same receiver, served over https
+	receiver := newTestReceiver(t, received)
+	receiver.Close()
+	server := httptest.NewTLSServer(receiver.Config.Handler)
+	defer server.Close()
+
+	// the certificate of the test server is self-signed, and issued for example.com and 127.0.0.1
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	writeTestFile(t, caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	otherHost := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+
+	tests := []struct {
+		name     string
+		endpoint string
+		tls      TLSOptions
+		wantErr  bool
+	}{
+		{name: "unknown ca", endpoint: server.URL, wantErr: true},
+		{name: "ca file", endpoint: server.URL, tls: TLSOptions{CAFile: caFile}},
+		{name: "host not in the certificate", endpoint: otherHost, tls: TLSOptions{CAFile: caFile}, wantErr: true},
+		{name: "server name", endpoint: otherHost, tls: TLSOptions{CAFile: caFile, ServerName: "example.com"}},
+		{name: "insecure skip verify", endpoint: otherHost, tls: TLSOptions{InsecureSkipVerify: true}},
+		{name: "missing ca file", endpoint: server.URL, tls: TLSOptions{CAFile: filepath.Join(dir, "missing.crt")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Run(context.Background(), dir, Options{Endpoint: tt.endpoint, TLS: tt.tls})
+			if tt.wantErr && err == nil {
+				t.Errorf("expected the replay to fail")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("replay failed: %s", err)
+			}
+		})
+	}
+	if received.spans != 3 {
+		t.Errorf("expected 3 spans received, got %d", received.spans)
+	}
+}
@@ -0,0 +1,54 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Download copies the objects under a bucket path to a local directory, using the command line tool of the provider
+// and the credentials it is configured with. Supported locations are gs://<bucket>/<prefix>, s3://<bucket>/<prefix>
+// and azblob://<account>/<container>/<prefix>.
+func Download(ctx context.Context, location string, dir string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("invalid source %s: %w", location, err)
+	}
+
+	var cmd *exec.Cmd
+	switch u.Scheme {
+	case "gs":
+		cmd = exec.CommandContext(ctx, "gcloud", "storage", "cp", "--recursive", location, dir)
+	case "s3":
+		cmd = exec.CommandContext(ctx, "aws", "s3", "cp", "--recursive", location, dir)
+	case "azblob":
+		container, prefix, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+		if container == "" {
+			return fmt.Errorf("invalid source %s: expected azblob://<account>/<container>/<prefix>", location)
+		}
+		cmd = exec.CommandContext(ctx, "az", "storage", "blob", "download-batch",
+			"--account-name", u.Host, "--source", container, "--destination", dir, "--pattern", prefix+"*")
+	default:
+		return fmt.Errorf("unsupported source %s, expected a local directory or a gs://, s3:// or azblob:// path", location)
+	}
+
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to download %s with %s: %w", location, cmd.Path, err)
+	}
+	return nil
+}
+
+// IsBucketPath returns true when the source is a bucket path rather than a local directory
+func IsBucketPath(source string) bool {
+	for _, scheme := range []string{"gs://", "s3://", "azblob://"} {
+		if strings.HasPrefix(source, scheme) {
+			return true
+		}
+	}
+	return false
+}
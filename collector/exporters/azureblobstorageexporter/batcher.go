@@ -0,0 +1,440 @@
+package azureblobstorageexporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxFlushInterval is how often, at most, the pending objects are checked for their age
+	maxFlushInterval = time.Second
+	// retryBackoff is the delay before a failed object is written again, doubled on each failed attempt up to maxRetryBackoff
+	retryBackoff    = time.Second
+	maxRetryBackoff = time.Minute
+	// defaultMaxRetryBytes caps the size of the objects waiting to be written again, new batches are refused past it
+	defaultMaxRetryBytes = 64 << 20
+)
+
+// objectBatcher buffers the batches of each signal and resource key into a single object, which is marshaled and
+// written once it reaches the max size or the max age, or when the exporter shuts down.
+// When no limit is configured, every batch is written as its own object.
+// Objects are marshaled while holding the lock and written after releasing it, so a slow write does not block other batches.
+// Objects that fail to be written are kept and retried until they are written. While too much data waits to be
+// written again, new batches are refused with an error, so the exporter helper retries or queues them instead.
+type objectBatcher struct {
+	writer    DataWriter
+	marshaler Marshaler
+	template  *keyTemplate
+	config    *Config
+	maxBytes  int
+	maxAge    time.Duration
+	logger    *zap.Logger
+	now       func() time.Time
+
+	// the otlp protobuf sizes estimate the size of the buffered data, whichever marshaler is used
+	tracesSizer  ptrace.ProtoMarshaler
+	logsSizer    plog.ProtoMarshaler
+	metricsSizer pmetric.ProtoMarshaler
+
+	mu      sync.Mutex
+	objects map[objectKey]*pendingObject
+	// retries holds the objects that failed to be written, oldest first
+	retries       []*readyObject
+	retryBytes    int
+	maxRetryBytes int
+	// lastWriteErr is the error of the last failed write, returned when batches are refused
+	lastWriteErr error
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// objectKey identifies the pending object batches are added to
+type objectKey struct {
+	signal      string
+	resourceKey string
+}
+
+// pendingObject holds the data of an object not written yet, only the field of its signal is used
+type pendingObject struct {
+	traces  ptrace.Traces
+	logs    plog.Logs
+	metrics pmetric.Metrics
+	// resource holds the attributes of the first resource added to the object, its key is rendered from them
+	resource pcommon.Map
+	// size is the estimated size of the buffered batches
+	size int
+	// created is the time the first batch was added to the object
+	created time.Time
+}
+
+// readyObject is a marshaled object waiting to be written
+type readyObject struct {
+	signal    string
+	keyPrefix string
+	buf       []byte
+	// attempts is the number of failed writes of the object
+	attempts    int
+	nextAttempt time.Time
+}
+
+func newPendingObject(resource pcommon.Map, created time.Time) *pendingObject {
+	object := &pendingObject{
+		traces:   ptrace.NewTraces(),
+		logs:     plog.NewLogs(),
+		metrics:  pmetric.NewMetrics(),
+		resource: pcommon.NewMap(),
+		created:  created,
+	}
+	resource.CopyTo(object.resource)
+	return object
+}
+
+func newObjectBatcher(writer DataWriter, marshaler Marshaler, template *keyTemplate, config *Config, logger *zap.Logger) *objectBatcher {
+	return &objectBatcher{
+		writer:    writer,
+		marshaler: marshaler,
+		template:  template,
+		config:    config,
+		maxBytes:  config.ABSUploader.MaxObjectBytes,
+		maxAge:    config.ABSUploader.MaxObjectAge,
+		logger:    logger,
+		now:       time.Now,
+		objects:   make(map[objectKey]*pendingObject),
+		stopCh:    make(chan struct{}),
+
+		maxRetryBytes: defaultMaxRetryBytes,
+	}
+}
+
+func (b *objectBatcher) enabled() bool {
+	return b.maxBytes > 0 || b.maxAge > 0
+}
+
+// WriteTraces splits the batch by the resource keys and writes, or buffers, each part on its own.
+// The returned error holds only the parts that failed, so the parts already written are not retried.
+func (b *objectBatcher) WriteTraces(ctx context.Context, td ptrace.Traces) error {
+	var errs error
+	failed := ptrace.NewTraces()
+	for resourceKey, batch := range b.template.splitTraces(td) {
+		if err := b.writeTraces(ctx, resourceKey, batch); err != nil {
+			errs = errors.Join(errs, err)
+			// the batch is copied since it may be the data the exporter got, which it does not mutate
+			resources := batch.ResourceSpans()
+			for i := 0; i < resources.Len(); i++ {
+				resources.At(i).CopyTo(failed.ResourceSpans().AppendEmpty())
+			}
+		}
+	}
+	if errs != nil {
+		return consumererror.NewTraces(errs, failed)
+	}
+	return nil
+}
+
+func (b *objectBatcher) writeTraces(ctx context.Context, resourceKey string, td ptrace.Traces) error {
+	resource := pcommon.NewMap()
+	if td.ResourceSpans().Len() > 0 {
+		resource = td.ResourceSpans().At(0).Resource().Attributes()
+	}
+
+	if !b.enabled() {
+		buf, err := b.marshaler.MarshalTraces(td)
+		if err != nil {
+			return err
+		}
+		return b.write(ctx, buf, "traces", resource)
+	}
+
+	key := objectKey{signal: "traces", resourceKey: resourceKey}
+	return b.add(ctx, key, resource, b.tracesSizer.TracesSize(td), func(object *pendingObject) {
+		// the batch is copied since the exporter does not mutate the data it gets
+		resourceSpans := td.ResourceSpans()
+		for i := 0; i < resourceSpans.Len(); i++ {
+			resourceSpans.At(i).CopyTo(object.traces.ResourceSpans().AppendEmpty())
+		}
+	})
+}
+
+// WriteLogs splits the batch by the resource keys and writes, or buffers, each part on its own.
+// The returned error holds only the parts that failed, so the parts already written are not retried.
+func (b *objectBatcher) WriteLogs(ctx context.Context, ld plog.Logs) error {
+	var errs error
+	failed := plog.NewLogs()
+	for resourceKey, batch := range b.template.splitLogs(ld) {
+		if err := b.writeLogs(ctx, resourceKey, batch); err != nil {
+			errs = errors.Join(errs, err)
+			// the batch is copied since it may be the data the exporter got, which it does not mutate
+			resources := batch.ResourceLogs()
+			for i := 0; i < resources.Len(); i++ {
+				resources.At(i).CopyTo(failed.ResourceLogs().AppendEmpty())
+			}
+		}
+	}
+	if errs != nil {
+		return consumererror.NewLogs(errs, failed)
+	}
+	return nil
+}
+
+func (b *objectBatcher) writeLogs(ctx context.Context, resourceKey string, ld plog.Logs) error {
+	resource := pcommon.NewMap()
+	if ld.ResourceLogs().Len() > 0 {
+		resource = ld.ResourceLogs().At(0).Resource().Attributes()
+	}
+
+	if !b.enabled() {
+		buf, err := b.marshaler.MarshalLogs(ld)
+		if err != nil {
+			return err
+		}
+		return b.write(ctx, buf, "logs", resource)
+	}
+
+	key := objectKey{signal: "logs", resourceKey: resourceKey}
+	return b.add(ctx, key, resource, b.logsSizer.LogsSize(ld), func(object *pendingObject) {
+		resourceLogs := ld.ResourceLogs()
+		for i := 0; i < resourceLogs.Len(); i++ {
+			resourceLogs.At(i).CopyTo(object.logs.ResourceLogs().AppendEmpty())
+		}
+	})
+}
+
+// WriteMetrics splits the batch by the resource keys and writes, or buffers, each part on its own.
+// The returned error holds only the parts that failed, so the parts already written are not retried.
+func (b *objectBatcher) WriteMetrics(ctx context.Context, md pmetric.Metrics) error {
+	var errs error
+	failed := pmetric.NewMetrics()
+	for resourceKey, batch := range b.template.splitMetrics(md) {
+		if err := b.writeMetrics(ctx, resourceKey, batch); err != nil {
+			errs = errors.Join(errs, err)
+			// the batch is copied since it may be the data the exporter got, which it does not mutate
+			resources := batch.ResourceMetrics()
+			for i := 0; i < resources.Len(); i++ {
+				resources.At(i).CopyTo(failed.ResourceMetrics().AppendEmpty())
+			}
+		}
+	}
+	if errs != nil {
+		return consumererror.NewMetrics(errs, failed)
+	}
+	return nil
+}
+
+func (b *objectBatcher) writeMetrics(ctx context.Context, resourceKey string, md pmetric.Metrics) error {
+	resource := pcommon.NewMap()
+	if md.ResourceMetrics().Len() > 0 {
+		resource = md.ResourceMetrics().At(0).Resource().Attributes()
+	}
+
+	if !b.enabled() {
+		buf, err := b.marshaler.MarshalMetrics(md)
+		if err != nil {
+			return err
+		}
+		return b.write(ctx, buf, "metrics", resource)
+	}
+
+	key := objectKey{signal: "metrics", resourceKey: resourceKey}
+	return b.add(ctx, key, resource, b.metricsSizer.MetricsSize(md), func(object *pendingObject) {
+		resourceMetrics := md.ResourceMetrics()
+		for i := 0; i < resourceMetrics.Len(); i++ {
+			resourceMetrics.At(i).CopyTo(object.metrics.ResourceMetrics().AppendEmpty())
+		}
+	})
+}
+
+// write writes a marshaled object under the key rendered for its signal and resource
+func (b *objectBatcher) write(ctx context.Context, buf []byte, signal string, resource pcommon.Map) error {
+	keyPrefix := b.template.render(signal, resource, b.now())
+	return b.writer.WriteBuffer(ctx, buf, b.config, keyPrefix, signal, b.marshaler.Format())
+}
+
+// add appends the batch to the pending object of its key. When the pending object is too large to add the batch,
+// or reaches the max size with it, it is written after releasing the lock.
+// The batch is refused when the failed objects reached the max retry size, since it could not be written either.
+func (b *objectBatcher) add(ctx context.Context, key objectKey, resource pcommon.Map, size int, appendBatch func(object *pendingObject)) error {
+	var ready []*readyObject
+
+	b.mu.Lock()
+	if b.retryBytes >= b.maxRetryBytes {
+		err := fmt.Errorf("%d bytes are waiting to be written again, refusing new data: %w", b.retryBytes, b.lastWriteErr)
+		b.mu.Unlock()
+		return err
+	}
+	object, ok := b.objects[key]
+	if ok && b.maxBytes > 0 && object.size+size > b.maxBytes {
+		ready = b.detachLocked(ready, key, object)
+		ok = false
+	}
+	if !ok {
+		object = newPendingObject(resource, b.now())
+		b.objects[key] = object
+	}
+
+	appendBatch(object)
+	object.size += size
+
+	if b.maxBytes > 0 && object.size >= b.maxBytes {
+		ready = b.detachLocked(ready, key, object)
+	}
+	b.mu.Unlock()
+
+	b.writeObjects(ctx, ready)
+	return nil
+}
+
+func (b *objectBatcher) start() {
+	if !b.enabled() {
+		return
+	}
+
+	b.wg.Add(1)
+	go b.flushLoop()
+}
+
+func (b *objectBatcher) flushLoop() {
+	defer b.wg.Done()
+
+	interval := b.maxAge
+	if interval <= 0 || interval > maxFlushInterval {
+		interval = maxFlushInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stopCh:
+			return
+		case <-ticker.C:
+			b.flushExpired(context.Background())
+		}
+	}
+}
+
+// flushExpired writes the pending objects whose first batch reached the max age,
+// and the failed objects whose retry delay is over
+func (b *objectBatcher) flushExpired(ctx context.Context) {
+	var ready []*readyObject
+
+	b.mu.Lock()
+	now := b.now()
+	if b.maxAge > 0 {
+		for key, object := range b.objects {
+			if now.Sub(object.created) >= b.maxAge {
+				ready = b.detachLocked(ready, key, object)
+			}
+		}
+	}
+
+	remaining := b.retries[:0]
+	for _, object := range b.retries {
+		if now.Before(object.nextAttempt) {
+			remaining = append(remaining, object)
+			continue
+		}
+		b.retryBytes -= len(object.buf)
+		ready = append(ready, object)
+	}
+	for i := len(remaining); i < len(b.retries); i++ {
+		b.retries[i] = nil
+	}
+	b.retries = remaining
+	b.mu.Unlock()
+
+	b.writeObjects(ctx, ready)
+}
+
+// shutdown stops the age based flushes and makes a last attempt to write all the pending and failed objects
+func (b *objectBatcher) shutdown(ctx context.Context) error {
+	b.stopOnce.Do(func() { close(b.stopCh) })
+	b.wg.Wait()
+
+	b.mu.Lock()
+	ready := b.retries
+	b.retries = nil
+	b.retryBytes = 0
+	for key, object := range b.objects {
+		ready = b.detachLocked(ready, key, object)
+	}
+	b.mu.Unlock()
+
+	var errs error
+	for _, object := range ready {
+		errs = errors.Join(errs, b.writeObject(ctx, object))
+	}
+	return errs
+}
+
+// detachLocked marshals the pending object and removes it, appending it to the objects to write.
+// an object that cannot be marshaled is dropped, since that would fail again. must be called with mu held.
+func (b *objectBatcher) detachLocked(ready []*readyObject, key objectKey, object *pendingObject) []*readyObject {
+	delete(b.objects, key)
+
+	buf, err := b.marshal(key.signal, object)
+	if err != nil {
+		b.logger.Error("Failed to marshal object, dropping it", zap.String("signal", key.signal), zap.Error(err))
+		return ready
+	}
+	// the key is rendered from the time the object was created, so it is under the time partition of its first batch
+	return append(ready, &readyObject{
+		signal:    key.signal,
+		keyPrefix: b.template.render(key.signal, object.resource, object.created),
+		buf:       buf,
+	})
+}
+
+// writeObjects writes the objects, the ones that fail are kept to be written again. must be called without mu held.
+func (b *objectBatcher) writeObjects(ctx context.Context, ready []*readyObject) {
+	for _, object := range ready {
+		if err := b.writeObject(ctx, object); err != nil {
+			b.retryLater(object, err)
+		}
+	}
+}
+
+func (b *objectBatcher) writeObject(ctx context.Context, object *readyObject) error {
+	return b.writer.WriteBuffer(ctx, object.buf, b.config, object.keyPrefix, object.signal, b.marshaler.Format())
+}
+
+// retryLater keeps a failed object to be written again after a backoff. Objects are never dropped, the data they
+// hold was already accepted from the pipeline.
+func (b *objectBatcher) retryLater(object *readyObject, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.logger.Warn("Failed to write object, keeping it for the next attempt", zap.String("signal", object.signal), zap.Int("attempts", object.attempts+1), zap.Error(err))
+
+	backoff := retryBackoff
+	for i := 0; i < object.attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	object.attempts++
+	object.nextAttempt = b.now().Add(min(backoff, maxRetryBackoff))
+	b.retries = append(b.retries, object)
+	b.retryBytes += len(object.buf)
+	b.lastWriteErr = err
+}
+
+func (b *objectBatcher) marshal(signal string, object *pendingObject) ([]byte, error) {
+	switch signal {
+	case "traces":
+		return b.marshaler.MarshalTraces(object.traces)
+	case "logs":
+		return b.marshaler.MarshalLogs(object.logs)
+	default:
+		return b.marshaler.MarshalMetrics(object.metrics)
+	}
+}
@@ -0,0 +1,248 @@
+package azureblobstorageexporter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+type fakeWriter struct {
+	objects [][]byte
+	keys    []string
+	err     error
+}
+
+func (w *fakeWriter) WriteBuffer(_ context.Context, buf []byte, _ *Config, keyPrefix string, _ string, _ string) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.objects = append(w.objects, append([]byte(nil), buf...))
+	w.keys = append(w.keys, keyPrefix)
+	return nil
+}
+
+func newTestBatcher(t *testing.T, writer DataWriter, maxBytes int, maxAge time.Duration) *objectBatcher {
+	config := createDefaultConfig().(*Config)
+	config.ABSUploader.MaxObjectBytes = maxBytes
+	config.ABSUploader.MaxObjectAge = maxAge
+	marshaler, err := NewMarshaler("otlp_proto", zap.NewNop())
+	require.NoError(t, err)
+	template, err := newKeyTemplate(config.ABSUploader)
+	require.NoError(t, err)
+	return newObjectBatcher(writer, marshaler, template, config, zap.NewNop())
+}
+
+func newTraces(name string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(name)
+	return td
+}
+
+func newLogs(body string) plog.Logs {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(body)
+	return ld
+}
+
+func unmarshalTraces(t *testing.T, buf []byte) ptrace.Traces {
+	td, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(buf)
+	require.NoError(t, err)
+	return td
+}
+
+func TestObjectBatcherRollsOverOnMaxBytes(t *testing.T) {
+	writer := &fakeWriter{}
+	batchSize := (&ptrace.ProtoMarshaler{}).TracesSize(newTraces("abcd"))
+	batcher := newTestBatcher(t, writer, 2*batchSize+batchSize/2, 0)
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("efgh")))
+	assert.Empty(t, writer.objects)
+
+	// the pending object cannot fit another batch
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("ijkl")))
+	require.Len(t, writer.objects, 1)
+	assert.Equal(t, 2, unmarshalTraces(t, writer.objects[0]).SpanCount())
+
+	require.NoError(t, batcher.shutdown(ctx))
+	require.Len(t, writer.objects, 2)
+	td := unmarshalTraces(t, writer.objects[1])
+	require.Equal(t, 1, td.SpanCount())
+	assert.Equal(t, "ijkl", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+func TestObjectBatcherRollsOverOnMaxAge(t *testing.T) {
+	writer := &fakeWriter{}
+	batcher := newTestBatcher(t, writer, 0, time.Minute)
+	now := time.Unix(0, 0)
+	batcher.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteLogs(ctx, newLogs("abcd")))
+	now = now.Add(30 * time.Second)
+	require.NoError(t, batcher.WriteLogs(ctx, newLogs("efgh")))
+	batcher.flushExpired(ctx)
+	assert.Empty(t, writer.objects)
+
+	now = now.Add(30 * time.Second)
+	batcher.flushExpired(ctx)
+	require.Len(t, writer.objects, 1)
+	ld, err := (&plog.ProtoUnmarshaler{}).UnmarshalLogs(writer.objects[0])
+	require.NoError(t, err)
+	assert.Equal(t, 2, ld.LogRecordCount())
+}
+
+func TestObjectBatcherRendersKeyFromCreationTime(t *testing.T) {
+	writer := &fakeWriter{}
+	batcher := newTestBatcher(t, writer, 0, time.Hour)
+	template, err := parseKeyTemplate("{{signal}}/hour={{HH}}/")
+	require.NoError(t, err)
+	batcher.template = template
+	now := time.Date(2024, 3, 1, 7, 59, 0, 0, time.UTC)
+	batcher.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	// the object is written in the next hour, under the partition of its first batch
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, batcher.shutdown(ctx))
+	require.Len(t, writer.keys, 1)
+	assert.Equal(t, "traces/hour=07/", writer.keys[0])
+}
+
+func TestObjectBatcherKeepsDataOnFailedWrite(t *testing.T) {
+	writer := &fakeWriter{err: errors.New("unavailable")}
+	batchSize := (&ptrace.ProtoMarshaler{}).TracesSize(newTraces("abcd"))
+	batcher := newTestBatcher(t, writer, batchSize+1, 0)
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	// the pending object fails to be written, it is kept to be written again and the new batch starts a new object
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("efgh")))
+	assert.Len(t, batcher.retries, 1)
+
+	writer.err = nil
+	require.NoError(t, batcher.shutdown(ctx))
+	require.Len(t, writer.objects, 2)
+	assert.Equal(t, 1, unmarshalTraces(t, writer.objects[0]).SpanCount())
+	assert.Equal(t, 1, unmarshalTraces(t, writer.objects[1]).SpanCount())
+}
+
+func TestObjectBatcherRetriesFailedWrites(t *testing.T) {
+	writer := &fakeWriter{err: errors.New("unavailable")}
+	batcher := newTestBatcher(t, writer, 1, 0)
+	now := time.Unix(0, 0)
+	batcher.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	require.Len(t, batcher.retries, 1)
+
+	// the object is not written again before the backoff is over
+	writer.err = nil
+	batcher.flushExpired(ctx)
+	assert.Empty(t, writer.objects)
+
+	now = now.Add(retryBackoff)
+	batcher.flushExpired(ctx)
+	require.Len(t, writer.objects, 1)
+	assert.Equal(t, 1, unmarshalTraces(t, writer.objects[0]).SpanCount())
+	assert.Empty(t, batcher.retries)
+	assert.Zero(t, batcher.retryBytes)
+}
+
+func TestObjectBatcherKeepsRetryingFailedWrites(t *testing.T) {
+	writer := &fakeWriter{err: errors.New("unavailable")}
+	batcher := newTestBatcher(t, writer, 1, 0)
+	now := time.Unix(0, 0)
+	batcher.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	for attempt := 1; attempt < 20; attempt++ {
+		require.Len(t, batcher.retries, 1)
+		// the backoff doubles on each attempt up to the max backoff
+		backoff := min(retryBackoff<<min(attempt-1, 10), maxRetryBackoff)
+		assert.Equal(t, now.Add(backoff), batcher.retries[0].nextAttempt)
+		now = now.Add(backoff)
+		batcher.flushExpired(ctx)
+	}
+	require.Len(t, batcher.retries, 1)
+
+	writer.err = nil
+	now = now.Add(maxRetryBackoff)
+	batcher.flushExpired(ctx)
+	require.Len(t, writer.objects, 1)
+	assert.Equal(t, 1, unmarshalTraces(t, writer.objects[0]).SpanCount())
+	assert.Empty(t, batcher.retries)
+}
+
+func TestObjectBatcherRefusesBatchesPastMaxRetryBytes(t *testing.T) {
+	writer := &fakeWriter{err: errors.New("unavailable")}
+	batcher := newTestBatcher(t, writer, 1, 0)
+	ctx := context.Background()
+
+	require.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	require.Len(t, batcher.retries, 1)
+	batcher.maxRetryBytes = batcher.retryBytes
+
+	// the batch is refused with the write error, so the exporter helper retries it, and no object is dropped
+	err := batcher.WriteTraces(ctx, newTraces("efgh"))
+	assert.ErrorContains(t, err, "unavailable")
+	require.Len(t, batcher.retries, 1)
+	assert.Empty(t, batcher.objects)
+
+	writer.err = nil
+	require.NoError(t, batcher.shutdown(ctx))
+	require.Len(t, writer.objects, 1)
+	td := unmarshalTraces(t, writer.objects[0])
+	assert.Equal(t, "abcd", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
+
+// blockingWriter blocks the first write until it is released
+type blockingWriter struct {
+	started chan struct{}
+	release chan struct{}
+	writes  atomic.Int32
+}
+
+func (w *blockingWriter) WriteBuffer(_ context.Context, _ []byte, _ *Config, _ string, _ string, _ string) error {
+	if w.writes.Add(1) == 1 {
+		close(w.started)
+		<-w.release
+	}
+	return nil
+}
+
+func TestObjectBatcherWritesWithoutHoldingLock(t *testing.T) {
+	writer := &blockingWriter{started: make(chan struct{}), release: make(chan struct{})}
+	batcher := newTestBatcher(t, writer, 1, 0)
+	ctx := context.Background()
+
+	go func() {
+		assert.NoError(t, batcher.WriteTraces(ctx, newTraces("abcd")))
+	}()
+	<-writer.started
+
+	// batches are added while the first object is being written
+	done := make(chan struct{})
+	go func() {
+		assert.NoError(t, batcher.WriteLogs(ctx, newLogs("efgh")))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("adding a batch is blocked by a write in progress")
+	}
+	close(writer.release)
+}
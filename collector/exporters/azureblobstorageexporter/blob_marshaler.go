@@ -2,15 +2,17 @@ package azureblobstorageexporter
 
 import (
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
 type AzureBlobMarshaler struct {
-	logsMarshaler   plog.Marshaler
-	tracesMarshaler ptrace.Marshaler
-	logger          *zap.Logger
-	format          string
+	logsMarshaler    plog.Marshaler
+	metricsMarshaler pmetric.Marshaler
+	tracesMarshaler  ptrace.Marshaler
+	logger           *zap.Logger
+	format           string
 }
 
 func (marshaler *AzureBlobMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
@@ -21,6 +23,10 @@ func (marshaler *AzureBlobMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
 	return marshaler.logsMarshaler.MarshalLogs(ld)
 }
 
+func (marshaler *AzureBlobMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	return marshaler.metricsMarshaler.MarshalMetrics(md)
+}
+
 func (marshaler *AzureBlobMarshaler) Format() string {
 	return marshaler.format
 }
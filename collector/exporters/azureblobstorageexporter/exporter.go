@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
@@ -19,6 +21,7 @@ type ABSExporter struct {
 	dataWriter DataWriter
 	logger     *zap.Logger
 	marshaler  Marshaler
+	batcher    *objectBatcher
 }
 
 func NewAzureBlobExporter(config *Config,
@@ -48,36 +51,47 @@ func NewAzureBlobExporter(config *Config,
 		return nil, errors.New("unknown marshaler")
 	}
 
+	template, err := newKeyTemplate(expConfig.ABSUploader)
+	if err != nil {
+		return nil, err
+	}
+
+	dataWriter := &ABSWriter{
+		azureClient: ac,
+	}
+
 	azureExporter := &ABSExporter{
-		config: config,
-		dataWriter: &ABSWriter{
-			azureClient: ac,
-		},
-		logger:    logger,
-		marshaler: marshaler,
+		config:     config,
+		dataWriter: dataWriter,
+		logger:     logger,
+		marshaler:  marshaler,
+		batcher:    newObjectBatcher(dataWriter, marshaler, template, config, logger),
 	}
 	return azureExporter, nil
 }
 
+func (e *ABSExporter) Start(_ context.Context, _ component.Host) error {
+	e.batcher.start()
+	return nil
+}
+
+// Shutdown writes the batches still buffered, so they are not lost
+func (e *ABSExporter) Shutdown(ctx context.Context) error {
+	return e.batcher.shutdown(ctx)
+}
+
 func (e *ABSExporter) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
 func (e *ABSExporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
-	buf, err := e.marshaler.MarshalLogs(logs)
-
-	if err != nil {
-		return err
-	}
+	return e.batcher.WriteLogs(ctx, logs)
+}
 
-	return e.dataWriter.WriteBuffer(ctx, buf, e.config, "logs", e.marshaler.Format())
+func (e *ABSExporter) ConsumeMetrics(ctx context.Context, metrics pmetric.Metrics) error {
+	return e.batcher.WriteMetrics(ctx, metrics)
 }
 
 func (e *ABSExporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
-	buf, err := e.marshaler.MarshalTraces(traces)
-	if err != nil {
-		return err
-	}
-
-	return e.dataWriter.WriteBuffer(ctx, buf, e.config, "traces", e.marshaler.Format())
+	return e.batcher.WriteTraces(ctx, traces)
 }
@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
@@ -19,17 +20,30 @@ func NewFactory() exporter.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		exporter.WithLogs(createLogsExporter, component.StabilityLevelBeta),
+		exporter.WithMetrics(createMetricsExporter, component.StabilityLevelBeta),
 		exporter.WithTraces(createTracesExporter, component.StabilityLevelBeta))
 }
 
 func createDefaultConfig() component.Config {
+	// the queue is opt-in, so batches are written before the pipeline returns unless it is enabled
+	queueSettings := exporterhelper.NewDefaultQueueSettings()
+	queueSettings.Enabled = false
+	// retries are opt-in as well, without the queue they would block the pipeline for as long as the storage is down
+	backOffConfig := configretry.NewDefaultBackOffConfig()
+	backOffConfig.Enabled = false
+
 	return &Config{
 		ABSUploader: AzureBlobStorageUploadConfig{
 			ABSPartition: "minute",
+			Compression:  compressionNone,
 		},
 
 		MarshalerName: "otlp_json",
 		logger:        nil,
+
+		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+		QueueSettings:   queueSettings,
+		BackOffConfig:   backOffConfig,
 	}
 }
 
@@ -48,7 +62,39 @@ func createLogsExporter(
 		ctx,
 		set,
 		cfg,
-		azureExporter.ConsumeLogs)
+		azureExporter.ConsumeLogs,
+		exporterhelper.WithStart(azureExporter.Start),
+		exporterhelper.WithShutdown(azureExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config) (exporter.Metrics, error) {
+
+	pCfg := cfg.(*Config)
+	if pCfg.MarshalerName == "parquet" {
+		return nil, ErrParquetMetrics
+	}
+
+	azureExporter, err := NewAzureBlobExporter(pCfg, set)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		azureExporter.ConsumeMetrics,
+		exporterhelper.WithStart(azureExporter.Start),
+		exporterhelper.WithShutdown(azureExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
 }
 
 func createTracesExporter(
@@ -69,6 +115,10 @@ func createTracesExporter(
 		azureExporter.ConsumeTraces,
 		exporterhelper.WithStart(func(ctx context.Context, host component.Host) error {
 			pCfg.logger.Info("Starting Azure Blob Storage exporter")
-			return nil
-		}))
+			return azureExporter.Start(ctx, host)
+		}),
+		exporterhelper.WithShutdown(azureExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
 }
@@ -0,0 +1,127 @@
+package azureblobstorageexporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestKeyTemplateRender(t *testing.T) {
+	template, err := parseKeyTemplate("{{signal}}/{{namespace}}/{{service}}/{{resource.k8s.deployment.name}}/dt={{yyyy-MM-dd}}/hour={{HH}}/")
+	require.NoError(t, err)
+
+	resource := pcommon.NewMap()
+	resource.PutStr("k8s.namespace.name", "checkout")
+	resource.PutStr("service.name", "payments/api")
+	at := time.Date(2024, 3, 1, 7, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, "traces/checkout/payments_api/unknown/dt=2024-03-01/hour=07/", template.render("traces", resource, at))
+	assert.Equal(t, "checkout/payments_api/unknown", template.resourceKey(resource))
+}
+
+func TestDefaultKeyTemplate(t *testing.T) {
+	template, err := newKeyTemplate(AzureBlobStorageUploadConfig{ABSPartition: "minute"})
+	require.NoError(t, err)
+
+	at := time.Date(2024, 3, 1, 7, 30, 0, 0, time.UTC)
+	assert.Equal(t, "year=2024/month=03/day=01/hour=07/minute=30/", template.render("logs", pcommon.NewMap(), at))
+	assert.False(t, template.byResource)
+}
+
+func TestKeyTemplateInvalid(t *testing.T) {
+	for _, template := range []string{"{{signal}}/{{team}}/", "{{signal", "{{yyyy/MM}}"} {
+		_, err := parseKeyTemplate(template)
+		assert.Error(t, err, template)
+	}
+}
+
+func TestObjectBatcherSplitsByRenderedKey(t *testing.T) {
+	writer := &fakeWriter{}
+	batcher := newTestBatcher(t, writer, 0, 0)
+	batcher.template, _ = parseKeyTemplate("{{signal}}/{{service}}/")
+	ctx := context.Background()
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"frontend", "cart", "frontend"} {
+		resourceSpans := td.ResourceSpans().AppendEmpty()
+		resourceSpans.Resource().Attributes().PutStr("service.name", service)
+		resourceSpans.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	}
+
+	require.NoError(t, batcher.WriteTraces(ctx, td))
+	require.Len(t, writer.objects, 2)
+
+	spansByKey := map[string]int{}
+	for i, key := range writer.keys {
+		spansByKey[key] = unmarshalTraces(t, writer.objects[i]).SpanCount()
+	}
+	assert.Equal(t, map[string]int{"traces/cart/": 1, "traces/frontend/": 2}, spansByKey)
+}
+
+func TestObjectBatcherReturnsOnlyFailedParts(t *testing.T) {
+	writer := &keyFailingWriter{failKey: "traces/cart/"}
+	batcher := newTestBatcher(t, writer, 0, 0)
+	batcher.template, _ = parseKeyTemplate("{{signal}}/{{service}}/")
+	ctx := context.Background()
+
+	td := ptrace.NewTraces()
+	for _, service := range []string{"frontend", "cart"} {
+		resourceSpans := td.ResourceSpans().AppendEmpty()
+		resourceSpans.Resource().Attributes().PutStr("service.name", service)
+		resourceSpans.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	}
+
+	err := batcher.WriteTraces(ctx, td)
+	require.Error(t, err)
+	assert.Equal(t, []string{"traces/frontend/"}, writer.keys)
+
+	// only the part that failed is retried, so the frontend object is not written twice
+	var tracesErr consumererror.Traces
+	require.ErrorAs(t, err, &tracesErr)
+	failed := tracesErr.Data()
+	require.Equal(t, 1, failed.ResourceSpans().Len())
+	service, _ := failed.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "cart", service.AsString())
+	assert.Equal(t, 2, td.ResourceSpans().Len())
+}
+
+// keyFailingWriter fails the writes of a single key
+type keyFailingWriter struct {
+	fakeWriter
+	failKey string
+}
+
+func (w *keyFailingWriter) WriteBuffer(ctx context.Context, buf []byte, config *Config, keyPrefix string, signal string, format string) error {
+	if keyPrefix == w.failKey {
+		return errors.New("unavailable")
+	}
+	return w.fakeWriter.WriteBuffer(ctx, buf, config, keyPrefix, signal, format)
+}
+
+// TestKeyTemplateMatchesS3 renders the key templates shared with the S3 destination, which must give the same keys
+func TestKeyTemplateMatchesS3(t *testing.T) {
+	data, err := os.ReadFile("../../../common/config/testdata/storage_key_templates.json")
+	require.NoError(t, err)
+	var cases []struct {
+		Template string    `json:"template"`
+		Signal   string    `json:"signal"`
+		Time     time.Time `json:"time"`
+		Key      string    `json:"key"`
+	}
+	require.NoError(t, json.Unmarshal(data, &cases))
+
+	for _, tc := range cases {
+		template, err := parseKeyTemplate(tc.Template)
+		require.NoError(t, err, tc.Template)
+		assert.Equal(t, tc.Key, template.render(tc.Signal, pcommon.NewMap(), tc.Time), tc.Template)
+	}
+}
@@ -0,0 +1,161 @@
+package azureblobstorageexporter
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const serviceNameAttribute = "service.name"
+
+var (
+	ErrParquetMetrics = errors.New("the parquet marshaler does not support metrics")
+)
+
+// spanRow is the schema of the span rows, documented in the README
+type spanRow struct {
+	TraceID            string            `parquet:"trace_id"`
+	SpanID             string            `parquet:"span_id"`
+	ParentSpanID       string            `parquet:"parent_span_id"`
+	Name               string            `parquet:"name"`
+	Kind               string            `parquet:"kind"`
+	ServiceName        string            `parquet:"service_name"`
+	StartTime          time.Time         `parquet:"start_time,timestamp(microsecond)"`
+	EndTime            time.Time         `parquet:"end_time,timestamp(microsecond)"`
+	DurationNs         int64             `parquet:"duration_ns"`
+	StatusCode         string            `parquet:"status_code"`
+	StatusMessage      string            `parquet:"status_message"`
+	ResourceAttributes map[string]string `parquet:"resource_attributes"`
+	Attributes         map[string]string `parquet:"attributes"`
+}
+
+// logRow is the schema of the log record rows, documented in the README
+type logRow struct {
+	Timestamp          time.Time         `parquet:"timestamp,timestamp(microsecond)"`
+	ObservedTimestamp  time.Time         `parquet:"observed_timestamp,timestamp(microsecond)"`
+	TraceID            string            `parquet:"trace_id"`
+	SpanID             string            `parquet:"span_id"`
+	SeverityNumber     int32             `parquet:"severity_number"`
+	SeverityText       string            `parquet:"severity_text"`
+	Body               string            `parquet:"body"`
+	ServiceName        string            `parquet:"service_name"`
+	ResourceAttributes map[string]string `parquet:"resource_attributes"`
+	Attributes         map[string]string `parquet:"attributes"`
+}
+
+// ParquetMarshaler flattens spans and log records into one row each
+type ParquetMarshaler struct{}
+
+func (marshaler *ParquetMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	rows := make([]spanRow, 0, td.SpanCount())
+
+	resourceSpans := td.ResourceSpans()
+	for i := 0; i < resourceSpans.Len(); i++ {
+		resource := resourceSpans.At(i).Resource()
+		serviceName := attributeString(resource.Attributes(), serviceNameAttribute)
+		resourceAttributes := attributesMap(resource.Attributes())
+
+		scopeSpans := resourceSpans.At(i).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				rows = append(rows, spanRow{
+					TraceID:            span.TraceID().String(),
+					SpanID:             span.SpanID().String(),
+					ParentSpanID:       span.ParentSpanID().String(),
+					Name:               span.Name(),
+					Kind:               span.Kind().String(),
+					ServiceName:        serviceName,
+					StartTime:          span.StartTimestamp().AsTime(),
+					EndTime:            span.EndTimestamp().AsTime(),
+					DurationNs:         int64(span.EndTimestamp()) - int64(span.StartTimestamp()),
+					StatusCode:         span.Status().Code().String(),
+					StatusMessage:      span.Status().Message(),
+					ResourceAttributes: resourceAttributes,
+					Attributes:         attributesMap(span.Attributes()),
+				})
+			}
+		}
+	}
+
+	return writeParquet(rows)
+}
+
+func (marshaler *ParquetMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	rows := make([]logRow, 0, ld.LogRecordCount())
+
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		resource := resourceLogs.At(i).Resource()
+		serviceName := attributeString(resource.Attributes(), serviceNameAttribute)
+		resourceAttributes := attributesMap(resource.Attributes())
+
+		scopeLogs := resourceLogs.At(i).ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			logRecords := scopeLogs.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				logRecord := logRecords.At(k)
+				rows = append(rows, logRow{
+					Timestamp:          logRecord.Timestamp().AsTime(),
+					ObservedTimestamp:  logRecord.ObservedTimestamp().AsTime(),
+					TraceID:            logRecord.TraceID().String(),
+					SpanID:             logRecord.SpanID().String(),
+					SeverityNumber:     int32(logRecord.SeverityNumber()),
+					SeverityText:       logRecord.SeverityText(),
+					Body:               logRecord.Body().AsString(),
+					ServiceName:        serviceName,
+					ResourceAttributes: resourceAttributes,
+					Attributes:         attributesMap(logRecord.Attributes()),
+				})
+			}
+		}
+	}
+
+	return writeParquet(rows)
+}
+
+// writeParquet writes the rows into a parquet file, as a single row group
+func writeParquet[T any](rows []T) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[T](&buf, parquet.CreatedBy("odigos", "", ""))
+	if _, err := writer.Write(rows); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (marshaler *ParquetMarshaler) MarshalMetrics(_ pmetric.Metrics) ([]byte, error) {
+	return nil, ErrParquetMetrics
+}
+
+func (marshaler *ParquetMarshaler) Format() string {
+	return "parquet"
+}
+
+func attributeString(attributes pcommon.Map, key string) string {
+	value, ok := attributes.Get(key)
+	if !ok {
+		return ""
+	}
+	return value.AsString()
+}
+
+// attributesMap flattens the attributes into strings, non string values are written in their json form
+func attributesMap(attributes pcommon.Map) map[string]string {
+	flattened := make(map[string]string, attributes.Len())
+	attributes.Range(func(key string, value pcommon.Value) bool {
+		flattened[key] = value.AsString()
+		return true
+	})
+	return flattened
+}
@@ -0,0 +1,71 @@
+package googlecloudstorageexporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	compressionNone = "none"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+)
+
+func validateCompression(compression string) error {
+	switch compression {
+	case "", compressionNone, compressionGzip, compressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+// compress returns the buffer compressed with the given algorithm, or the buffer itself when there is no compression
+func compress(buf []byte, compression string) ([]byte, error) {
+	switch compression {
+	case compressionGzip:
+		var compressed bytes.Buffer
+		gz := gzip.NewWriter(&compressed)
+		if _, err := gz.Write(buf); err != nil {
+			return nil, err
+		}
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+		return compressed.Bytes(), nil
+	case compressionZstd:
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		defer encoder.Close()
+		return encoder.EncodeAll(buf, nil), nil
+	default:
+		return buf, nil
+	}
+}
+
+// contentEncoding returns the content encoding of objects written with the given compression
+func contentEncoding(compression string) string {
+	switch compression {
+	case compressionGzip, compressionZstd:
+		return compression
+	default:
+		return ""
+	}
+}
+
+// compressionExtension returns the file extension added to objects written with the given compression
+func compressionExtension(compression string) string {
+	switch compression {
+	case compressionGzip:
+		return ".gz"
+	case compressionZstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
@@ -1,6 +1,11 @@
 package googlecloudstorageexporter
 
 import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
 	"go.uber.org/zap"
 )
 
@@ -9,6 +14,15 @@ type GCSUploadConfig struct {
 	GCSPrefix    string `mapstructure:"prefix"`
 	GCSPartition string `mapstructure:"partition"`
 	FilePrefix   string `mapstructure:"file_prefix"`
+	// KeyTemplate is the path objects are written under, replacing the prefix and the partition when set
+	KeyTemplate string `mapstructure:"key_template"`
+
+	// MaxObjectBytes buffers batches into a single object until it reaches this size, 0 disables the size limit
+	MaxObjectBytes int `mapstructure:"max_object_bytes"`
+	// MaxObjectAge buffers batches into a single object until its first batch reaches this age, 0 disables the age limit
+	MaxObjectAge time.Duration `mapstructure:"max_object_age"`
+	// Compression of the written objects, one of none, gzip or zstd
+	Compression string `mapstructure:"compression"`
 }
 
 // Config contains the main configuration options for the awskinesis exporter
@@ -16,5 +30,28 @@ type Config struct {
 	GCSUploader   GCSUploadConfig `mapstructure:"gcs"`
 	MarshalerName string          `mapstructure:"marshaler_name"`
 
+	// failed writes are returned to the exporter helper, which retries them and can queue the batches
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`
+	exporterhelper.QueueSettings   `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+
 	logger *zap.Logger
 }
+
+func (c *Config) Validate() error {
+	if err := c.QueueSettings.Validate(); err != nil {
+		return err
+	}
+	if c.GCSUploader.MaxObjectBytes < 0 {
+		return errors.New("max_object_bytes cannot be negative")
+	}
+	if c.GCSUploader.MaxObjectAge < 0 {
+		return errors.New("max_object_age cannot be negative")
+	}
+	if c.GCSUploader.KeyTemplate != "" {
+		if _, err := parseKeyTemplate(c.GCSUploader.KeyTemplate); err != nil {
+			return err
+		}
+	}
+	return validateCompression(c.GCSUploader.Compression)
+}
@@ -3,5 +3,5 @@ package googlecloudstorageexporter
 import "context"
 
 type DataWriter interface {
-	WriteBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error
+	WriteBuffer(ctx context.Context, buf []byte, config *Config, keyPrefix string, metadata string, format string) error
 }
@@ -4,10 +4,12 @@ import (
 	"cloud.google.com/go/storage"
 	"context"
 	"errors"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
@@ -17,6 +19,7 @@ type GCSExporter struct {
 	dataWriter DataWriter
 	logger     *zap.Logger
 	marshaler  Marshaler
+	batcher    *objectBatcher
 }
 
 func NewGCSExporter(config *Config,
@@ -46,36 +49,47 @@ func NewGCSExporter(config *Config,
 		return nil, errors.New("unknown marshaler")
 	}
 
+	template, err := newKeyTemplate(expConfig.GCSUploader)
+	if err != nil {
+		return nil, err
+	}
+
+	dataWriter := &GCSWriter{
+		gcsClient: gcs,
+	}
+
 	gcsExporter := &GCSExporter{
-		config: config,
-		dataWriter: &GCSWriter{
-			gcsClient: gcs,
-		},
-		logger:    logger,
-		marshaler: marshaler,
+		config:     config,
+		dataWriter: dataWriter,
+		logger:     logger,
+		marshaler:  marshaler,
+		batcher:    newObjectBatcher(dataWriter, marshaler, template, config, logger),
 	}
 	return gcsExporter, nil
 }
 
+func (e *GCSExporter) Start(_ context.Context, _ component.Host) error {
+	e.batcher.start()
+	return nil
+}
+
+// Shutdown writes the batches still buffered, so they are not lost
+func (e *GCSExporter) Shutdown(ctx context.Context) error {
+	return e.batcher.shutdown(ctx)
+}
+
 func (e *GCSExporter) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
 func (e *GCSExporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
-	buf, err := e.marshaler.MarshalLogs(logs)
-
-	if err != nil {
-		return err
-	}
+	return e.batcher.WriteLogs(ctx, logs)
+}
 
-	return e.dataWriter.WriteBuffer(ctx, buf, e.config, "logs", e.marshaler.Format())
+func (e *GCSExporter) ConsumeMetrics(ctx context.Context, metrics pmetric.Metrics) error {
+	return e.batcher.WriteMetrics(ctx, metrics)
 }
 
 func (e *GCSExporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
-	buf, err := e.marshaler.MarshalTraces(traces)
-	if err != nil {
-		return err
-	}
-
-	return e.dataWriter.WriteBuffer(ctx, buf, e.config, "traces", e.marshaler.Format())
+	return e.batcher.WriteTraces(ctx, traces)
 }
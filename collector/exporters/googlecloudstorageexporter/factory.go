@@ -7,6 +7,7 @@ import (
 	"go.opentelemetry.io/collector/exporter"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
@@ -16,17 +17,30 @@ func NewFactory() exporter.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		exporter.WithLogs(createLogsExporter, component.StabilityLevelBeta),
+		exporter.WithMetrics(createMetricsExporter, component.StabilityLevelBeta),
 		exporter.WithTraces(createTracesExporter, component.StabilityLevelBeta))
 }
 
 func createDefaultConfig() component.Config {
+	// the queue is opt-in, so batches are written before the pipeline returns unless it is enabled
+	queueSettings := exporterhelper.NewDefaultQueueSettings()
+	queueSettings.Enabled = false
+	// retries are opt-in as well, without the queue they would block the pipeline for as long as the storage is down
+	backOffConfig := configretry.NewDefaultBackOffConfig()
+	backOffConfig.Enabled = false
+
 	return &Config{
 		GCSUploader: GCSUploadConfig{
 			GCSPartition: "minute",
+			Compression:  compressionNone,
 		},
 
 		MarshalerName: "otlp_json",
 		logger:        nil,
+
+		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+		QueueSettings:   queueSettings,
+		BackOffConfig:   backOffConfig,
 	}
 }
 
@@ -45,7 +59,39 @@ func createLogsExporter(
 		ctx,
 		set,
 		cfg,
-		gcsExporter.ConsumeLogs)
+		gcsExporter.ConsumeLogs,
+		exporterhelper.WithStart(gcsExporter.Start),
+		exporterhelper.WithShutdown(gcsExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	cfg component.Config) (exporter.Metrics, error) {
+
+	pCfg := cfg.(*Config)
+	if pCfg.MarshalerName == "parquet" {
+		return nil, ErrParquetMetrics
+	}
+
+	gcsExporter, err := NewGCSExporter(pCfg, set)
+	if err != nil {
+		return nil, err
+	}
+
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		gcsExporter.ConsumeMetrics,
+		exporterhelper.WithStart(gcsExporter.Start),
+		exporterhelper.WithShutdown(gcsExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
 }
 
 func createTracesExporter(
@@ -66,6 +112,10 @@ func createTracesExporter(
 		gcsExporter.ConsumeTraces,
 		exporterhelper.WithStart(func(ctx context.Context, host component.Host) error {
 			pCfg.logger.Info("Starting GCS exporter")
-			return nil
-		}))
+			return gcsExporter.Start(ctx, host)
+		}),
+		exporterhelper.WithShutdown(gcsExporter.Shutdown),
+		exporterhelper.WithTimeout(pCfg.TimeoutSettings),
+		exporterhelper.WithQueue(pCfg.QueueSettings),
+		exporterhelper.WithRetry(pCfg.BackOffConfig))
 }
@@ -3,49 +3,36 @@ package googlecloudstorageexporter
 import (
 	"cloud.google.com/go/storage"
 	"context"
-	"fmt"
 	"go.uber.org/zap"
 	"math/rand"
 	"strconv"
-	"time"
 )
 
 type GCSWriter struct {
 	gcsClient *storage.Client
 }
 
-// generate the gcs time key based on partition configuration
-func getTimeKey(time time.Time, partition string) string {
-	var timeKey string
-	year, month, day := time.Date()
-	hour, minute, _ := time.Clock()
-
-	if partition == "hour" {
-		timeKey = fmt.Sprintf("year=%d/month=%02d/day=%02d/hour=%02d", year, month, day, hour)
-	} else {
-		timeKey = fmt.Sprintf("year=%d/month=%02d/day=%02d/hour=%02d/minute=%02d", year, month, day, hour, minute)
-	}
-	return timeKey
-}
-
 func randomInRange(low, hi int) int {
 	return low + rand.Intn(hi-low)
 }
 
-func getGCSKey(time time.Time, keyPrefix string, partition string, filePrefix string, metadata string, fileformat string) string {
-	timeKey := getTimeKey(time, partition)
+// getGCSKey returns the object name under the key prefix rendered from the key template
+func getGCSKey(keyPrefix string, filePrefix string, metadata string, fileformat string) string {
 	randomID := randomInRange(100000000, 999999999)
 
-	gcsKey := keyPrefix + "/" + timeKey + "/" + filePrefix + metadata + "_" + strconv.Itoa(randomID) + "." + fileformat
+	gcsKey := keyPrefix + filePrefix + metadata + "_" + strconv.Itoa(randomID) + "." + fileformat
 
 	return gcsKey
 }
 
-func (gcsWriter *GCSWriter) WriteBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error {
-	now := time.Now()
-	key := getGCSKey(now,
-		config.GCSUploader.GCSPrefix, config.GCSUploader.GCSPartition,
-		config.GCSUploader.FilePrefix, metadata, format)
+func (gcsWriter *GCSWriter) WriteBuffer(ctx context.Context, buf []byte, config *Config, keyPrefix string, metadata string, format string) error {
+	compression := config.GCSUploader.Compression
+	data, err := compress(buf, compression)
+	if err != nil {
+		return err
+	}
+
+	key := getGCSKey(keyPrefix, config.GCSUploader.FilePrefix, metadata, format+compressionExtension(compression))
 
 	config.logger.Info("Writing to GCS", zap.String("key", key))
 
@@ -53,9 +40,10 @@ func (gcsWriter *GCSWriter) WriteBuffer(ctx context.Context, buf []byte, config
 	bucket := gcsWriter.gcsClient.Bucket(config.GCSUploader.GCSBucket)
 	obj := bucket.Object(key)
 	w := obj.NewWriter(ctx)
+	w.ContentEncoding = contentEncoding(compression)
 
 	// write the buffer to GCS
-	_, err := w.Write(buf)
+	_, err = w.Write(data)
 	if err != nil {
 		return err
 	}
@@ -42,6 +42,13 @@ func TestComponentLifecycle(t *testing.T) {
 			},
 		},
 
+		{
+			name: "metrics",
+			createFn: func(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (component.Component, error) {
+				return factory.CreateMetricsExporter(ctx, set, cfg)
+			},
+		},
+
 		{
 			name: "traces",
 			createFn: func(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (component.Component, error) {
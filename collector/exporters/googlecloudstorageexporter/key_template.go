@@ -0,0 +1,230 @@
+package googlecloudstorageexporter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// unknownKeyValue replaces resource attributes missing from the resource
+const unknownKeyValue = "unknown"
+
+// keyTemplate renders the path objects are written under. Placeholders are written as {{name}}, one of:
+// signal, namespace, service, resource.<attribute> or a time format made of yyyy, MM, dd, HH and mm.
+type keyTemplate struct {
+	segments []keySegment
+	// byResource is set when the key depends on resource attributes, so batches are split by resource
+	byResource bool
+}
+
+// keySegment is either a literal or a single placeholder of the template
+type keySegment struct {
+	literal    string
+	signal     bool
+	attribute  string
+	timeFormat string
+}
+
+// newKeyTemplate parses the configured key template, or builds the default one from the prefix and the partition
+func newKeyTemplate(upload GCSUploadConfig) (*keyTemplate, error) {
+	if upload.KeyTemplate != "" {
+		return parseKeyTemplate(upload.KeyTemplate)
+	}
+
+	timeKey := "year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}"
+	if upload.GCSPartition != "hour" {
+		timeKey += "/minute={{mm}}"
+	}
+	return parseKeyTemplate(upload.GCSPrefix + "/" + timeKey + "/")
+}
+
+func parseKeyTemplate(template string) (*keyTemplate, error) {
+	kt := &keyTemplate{}
+	for len(template) > 0 {
+		start := strings.Index(template, "{{")
+		if start < 0 {
+			kt.segments = append(kt.segments, keySegment{literal: template})
+			break
+		}
+		if start > 0 {
+			kt.segments = append(kt.segments, keySegment{literal: template[:start]})
+		}
+
+		end := strings.Index(template[start:], "}}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed placeholder in key template: %s", template[start:])
+		}
+		segment, err := parsePlaceholder(strings.TrimSpace(template[start+2 : start+end]))
+		if err != nil {
+			return nil, err
+		}
+		if segment.attribute != "" {
+			kt.byResource = true
+		}
+		kt.segments = append(kt.segments, segment)
+		template = template[start+end+2:]
+	}
+	return kt, nil
+}
+
+func parsePlaceholder(name string) (keySegment, error) {
+	switch {
+	case name == "signal":
+		return keySegment{signal: true}, nil
+	case name == "namespace":
+		return keySegment{attribute: "k8s.namespace.name"}, nil
+	case name == "service":
+		return keySegment{attribute: serviceNameAttribute}, nil
+	case strings.HasPrefix(name, "resource.") && len(name) > len("resource."):
+		return keySegment{attribute: strings.TrimPrefix(name, "resource.")}, nil
+	}
+
+	if _, ok := formatKeyTime(name, time.Time{}); ok {
+		return keySegment{timeFormat: name}, nil
+	}
+	return keySegment{}, fmt.Errorf("unknown placeholder in key template: {{%s}}", name)
+}
+
+// formatKeyTime formats the time by a format such as yyyy-MM-dd, which may only hold time tokens and separators
+func formatKeyTime(format string, t time.Time) (string, bool) {
+	tokens := []struct {
+		token string
+		value string
+	}{
+		{"yyyy", fmt.Sprintf("%04d", t.Year())},
+		{"MM", fmt.Sprintf("%02d", t.Month())},
+		{"dd", fmt.Sprintf("%02d", t.Day())},
+		{"HH", fmt.Sprintf("%02d", t.Hour())},
+		{"mm", fmt.Sprintf("%02d", t.Minute())},
+	}
+
+	var formatted strings.Builder
+	found := false
+	for len(format) > 0 {
+		matched := false
+		for _, token := range tokens {
+			if strings.HasPrefix(format, token.token) {
+				formatted.WriteString(token.value)
+				format = format[len(token.token):]
+				matched, found = true, true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+		if !strings.ContainsRune("-_.", rune(format[0])) {
+			return "", false
+		}
+		formatted.WriteByte(format[0])
+		format = format[1:]
+	}
+	return formatted.String(), found
+}
+
+// render returns the key of an object of the signal, holding data of the resource and written at the given time
+func (kt *keyTemplate) render(signal string, resource pcommon.Map, t time.Time) string {
+	var key strings.Builder
+	for _, segment := range kt.segments {
+		switch {
+		case segment.signal:
+			key.WriteString(signal)
+		case segment.attribute != "":
+			key.WriteString(attributeKeyValue(resource, segment.attribute))
+		case segment.timeFormat != "":
+			formatted, _ := formatKeyTime(segment.timeFormat, t)
+			key.WriteString(formatted)
+		default:
+			key.WriteString(segment.literal)
+		}
+	}
+	return key.String()
+}
+
+// resourceKey returns the part of the key that depends on the resource, data of resources with the same resource key
+// is written to the same object
+func (kt *keyTemplate) resourceKey(resource pcommon.Map) string {
+	if !kt.byResource {
+		return ""
+	}
+
+	var values []string
+	for _, segment := range kt.segments {
+		if segment.attribute != "" {
+			values = append(values, attributeKeyValue(resource, segment.attribute))
+		}
+	}
+	return strings.Join(values, "/")
+}
+
+// attributeKeyValue returns the attribute value to use in a key, without path separators
+func attributeKeyValue(resource pcommon.Map, attribute string) string {
+	value := attributeString(resource, attribute)
+	if value == "" {
+		return unknownKeyValue
+	}
+	return strings.ReplaceAll(value, "/", "_")
+}
+
+// splitTraces splits the batch by the resource key, a batch that does not need to be split is returned as is
+func (kt *keyTemplate) splitTraces(td ptrace.Traces) map[string]ptrace.Traces {
+	if !kt.byResource {
+		return map[string]ptrace.Traces{"": td}
+	}
+
+	batches := make(map[string]ptrace.Traces)
+	resourceSpans := td.ResourceSpans()
+	for i := 0; i < resourceSpans.Len(); i++ {
+		key := kt.resourceKey(resourceSpans.At(i).Resource().Attributes())
+		batch, ok := batches[key]
+		if !ok {
+			batch = ptrace.NewTraces()
+			batches[key] = batch
+		}
+		resourceSpans.At(i).CopyTo(batch.ResourceSpans().AppendEmpty())
+	}
+	return batches
+}
+
+func (kt *keyTemplate) splitLogs(ld plog.Logs) map[string]plog.Logs {
+	if !kt.byResource {
+		return map[string]plog.Logs{"": ld}
+	}
+
+	batches := make(map[string]plog.Logs)
+	resourceLogs := ld.ResourceLogs()
+	for i := 0; i < resourceLogs.Len(); i++ {
+		key := kt.resourceKey(resourceLogs.At(i).Resource().Attributes())
+		batch, ok := batches[key]
+		if !ok {
+			batch = plog.NewLogs()
+			batches[key] = batch
+		}
+		resourceLogs.At(i).CopyTo(batch.ResourceLogs().AppendEmpty())
+	}
+	return batches
+}
+
+func (kt *keyTemplate) splitMetrics(md pmetric.Metrics) map[string]pmetric.Metrics {
+	if !kt.byResource {
+		return map[string]pmetric.Metrics{"": md}
+	}
+
+	batches := make(map[string]pmetric.Metrics)
+	resourceMetrics := md.ResourceMetrics()
+	for i := 0; i < resourceMetrics.Len(); i++ {
+		key := kt.resourceKey(resourceMetrics.At(i).Resource().Attributes())
+		batch, ok := batches[key]
+		if !ok {
+			batch = pmetric.NewMetrics()
+			batches[key] = batch
+		}
+		resourceMetrics.At(i).CopyTo(batch.ResourceMetrics().AppendEmpty())
+	}
+	return batches
+}
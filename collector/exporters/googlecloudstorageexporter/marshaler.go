@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
@@ -11,6 +12,7 @@ import (
 type Marshaler interface {
 	MarshalTraces(td ptrace.Traces) ([]byte, error)
 	MarshalLogs(ld plog.Logs) ([]byte, error)
+	MarshalMetrics(md pmetric.Metrics) ([]byte, error)
 	Format() string
 }
 
@@ -23,12 +25,16 @@ func NewMarshaler(name string, logger *zap.Logger) (Marshaler, error) {
 	switch name {
 	case "otlp", "otlp_proto":
 		marshaler.logsMarshaler = &plog.ProtoMarshaler{}
+		marshaler.metricsMarshaler = &pmetric.ProtoMarshaler{}
 		marshaler.tracesMarshaler = &ptrace.ProtoMarshaler{}
 		marshaler.format = "proto"
 	case "otlp_json":
 		marshaler.logsMarshaler = &plog.JSONMarshaler{}
+		marshaler.metricsMarshaler = &pmetric.JSONMarshaler{}
 		marshaler.tracesMarshaler = &ptrace.JSONMarshaler{}
 		marshaler.format = "json"
+	case "parquet":
+		return &ParquetMarshaler{}, nil
 	default:
 		return nil, ErrUnknownMarshaler
 	}
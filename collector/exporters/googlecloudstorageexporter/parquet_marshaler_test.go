@@ -0,0 +1,114 @@
+package googlecloudstorageexporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var testStartTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// readParquet checks the file has the schema of the golden file in a single row group, and reads its rows back
+func readParquet[T any](t *testing.T, buf []byte, goldenSchema string) []T {
+	file, err := parquet.OpenFile(bytes.NewReader(buf), int64(len(buf)))
+	require.NoError(t, err)
+	require.Len(t, file.RowGroups(), 1)
+	assert.Equal(t, readGoldenFile(t, goldenSchema), file.Schema().String()+"\n")
+
+	rows, err := parquet.Read[T](bytes.NewReader(buf), int64(len(buf)))
+	require.NoError(t, err)
+	return rows
+}
+
+// assertGoldenRows compares the rows read back from the file with the golden file, as indented json
+func assertGoldenRows(t *testing.T, goldenRows string, rows any) {
+	got, err := json.MarshalIndent(rows, "", "  ")
+	require.NoError(t, err)
+	assert.JSONEq(t, readGoldenFile(t, goldenRows), string(got))
+}
+
+func readGoldenFile(t *testing.T, path string) string {
+	want, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(want)
+}
+
+func TestParquetMarshalTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	resourceSpans := td.ResourceSpans().AppendEmpty()
+	resourceSpans.Resource().Attributes().PutStr("service.name", "frontend")
+	resourceSpans.Resource().Attributes().PutStr("k8s.namespace.name", "default")
+	spans := resourceSpans.ScopeSpans().AppendEmpty().Spans()
+
+	server := spans.AppendEmpty()
+	server.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	server.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	server.SetName("GET /products")
+	server.SetKind(ptrace.SpanKindServer)
+	server.SetStartTimestamp(pcommon.NewTimestampFromTime(testStartTime))
+	server.SetEndTimestamp(pcommon.NewTimestampFromTime(testStartTime.Add(150 * time.Millisecond)))
+	server.Status().SetCode(ptrace.StatusCodeError)
+	server.Status().SetMessage("internal error")
+	server.Attributes().PutStr("http.route", "/products")
+	server.Attributes().PutInt("http.status_code", 500)
+
+	client := spans.AppendEmpty()
+	client.SetTraceID(server.TraceID())
+	client.SetSpanID(pcommon.SpanID{8, 7, 6, 5, 4, 3, 2, 1})
+	client.SetParentSpanID(server.SpanID())
+	client.SetName("SELECT products")
+	client.SetKind(ptrace.SpanKindClient)
+	client.SetStartTimestamp(pcommon.NewTimestampFromTime(testStartTime.Add(10 * time.Millisecond)))
+	client.SetEndTimestamp(pcommon.NewTimestampFromTime(testStartTime.Add(110 * time.Millisecond)))
+
+	buf, err := (&ParquetMarshaler{}).MarshalTraces(td)
+	require.NoError(t, err)
+
+	// the schema and rows are the same in every exporter writing parquet, so they are compared with golden files
+	rows := readParquet[spanRow](t, buf, "testdata/spans.schema")
+	require.Len(t, rows, 2)
+	for i := range rows {
+		rows[i].StartTime, rows[i].EndTime = rows[i].StartTime.UTC(), rows[i].EndTime.UTC()
+	}
+	assertGoldenRows(t, "testdata/spans.json", rows)
+}
+
+func TestParquetMarshalLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	resourceLogs := ld.ResourceLogs().AppendEmpty()
+	resourceLogs.Resource().Attributes().PutStr("service.name", "frontend")
+	logRecords := resourceLogs.ScopeLogs().AppendEmpty().LogRecords()
+
+	logRecord := logRecords.AppendEmpty()
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(testStartTime))
+	logRecord.SetObservedTimestamp(pcommon.NewTimestampFromTime(testStartTime.Add(time.Second)))
+	logRecord.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	logRecord.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+	logRecord.SetSeverityNumber(plog.SeverityNumberError)
+	logRecord.SetSeverityText("ERROR")
+	logRecord.Body().SetStr("failed to list products")
+	logRecord.Attributes().PutBool("retry", true)
+
+	buf, err := (&ParquetMarshaler{}).MarshalLogs(ld)
+	require.NoError(t, err)
+
+	rows := readParquet[logRow](t, buf, "testdata/logs.schema")
+	require.Len(t, rows, 1)
+	rows[0].Timestamp, rows[0].ObservedTimestamp = rows[0].Timestamp.UTC(), rows[0].ObservedTimestamp.UTC()
+	assertGoldenRows(t, "testdata/logs.json", rows)
+}
+
+func TestParquetMarshalMetricsNotSupported(t *testing.T) {
+	_, err := (&ParquetMarshaler{}).MarshalMetrics(pmetric.NewMetrics())
+	assert.ErrorIs(t, err, ErrParquetMetrics)
+}
@@ -1,6 +1,10 @@
 package odigosresourcenameprocessor
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"go.opentelemetry.io/collector/component"
 )
@@ -8,6 +12,64 @@ import (
 // Config defines configuration for Resource processor.
 type Config struct {
 	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// TerminatedPodTTL is how long the names of a terminated pod are kept, for telemetry still in flight.
+	TerminatedPodTTL time.Duration `mapstructure:"terminated_pod_ttl"`
+
+	// UnknownDeviceTTL is how long a device id the kubelet does not report is remembered,
+	// before it triggers listing the allocations again.
+	UnknownDeviceTTL time.Duration `mapstructure:"unknown_device_ttl"`
+
+	// Extract copies pod labels and annotations into resource attributes.
+	Extract ExtractConfig `mapstructure:"extract"`
+}
+
+// ExtractConfig holds the rules to copy pod labels and annotations into resource attributes.
+type ExtractConfig struct {
+	Labels      []FieldExtractConfig `mapstructure:"labels"`
+	Annotations []FieldExtractConfig `mapstructure:"annotations"`
+}
+
+// FieldExtractConfig selects pod labels or annotations by exact key or by key prefix.
+type FieldExtractConfig struct {
+	// Key is the label or annotation key to copy.
+	Key string `mapstructure:"key"`
+
+	// KeyPrefix copies every label or annotation whose key starts with the prefix.
+	KeyPrefix string `mapstructure:"key_prefix"`
+
+	// TagName renames the resource attribute, for a key prefix it replaces the prefix in the attribute names.
+	// Defaults to k8s.pod.labels.<key> or k8s.pod.annotations.<key>.
+	TagName string `mapstructure:"tag_name"`
 }
 
 var _ component.Config = (*Config)(nil)
+
+func (c *Config) Validate() error {
+	if c.TerminatedPodTTL < 0 {
+		return errors.New("terminated_pod_ttl must not be negative")
+	}
+	if c.UnknownDeviceTTL < 0 {
+		return errors.New("unknown_device_ttl must not be negative")
+	}
+	if err := validateFieldExtractConfigs("labels", c.Extract.Labels); err != nil {
+		return err
+	}
+	if err := validateFieldExtractConfigs("annotations", c.Extract.Annotations); err != nil {
+		return err
+	}
+	return c.APIConfig.Validate()
+}
+
+func (e ExtractConfig) enabled() bool {
+	return len(e.Labels) > 0 || len(e.Annotations) > 0
+}
+
+func validateFieldExtractConfigs(field string, rules []FieldExtractConfig) error {
+	for i, rule := range rules {
+		if (rule.Key == "") == (rule.KeyPrefix == "") {
+			return fmt.Errorf("extract.%s[%d]: exactly one of key and key_prefix must be set", field, i)
+		}
+	}
+	return nil
+}
@@ -16,7 +16,8 @@ package odigosresourcenameprocessor // import "github.com/open-telemetry/opentel
 
 import (
 	"context"
-	"sync"
+	"os"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigosresourcenameprocessor/internal/metadata"
 
@@ -43,7 +44,9 @@ func NewFactory() processor.Factory {
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+		APIConfig:        k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+		TerminatedPodTTL: time.Minute,
+		UnknownDeviceTTL: 10 * time.Second,
 	}
 }
 
@@ -53,7 +56,7 @@ func createTracesProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Traces) (processor.Traces, error) {
 
-	if err := initNameResolver(cfg, set.Logger); err != nil {
+	if err := initNameResolver(cfg, set.TelemetrySettings); err != nil {
 		set.Logger.Error("failed to initialize name resolver", zap.Error(err))
 	}
 
@@ -74,7 +77,7 @@ func createMetricsProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Metrics) (processor.Metrics, error) {
 
-	if err := initNameResolver(cfg, set.Logger); err != nil {
+	if err := initNameResolver(cfg, set.TelemetrySettings); err != nil {
 		set.Logger.Error("failed to initialize name resolver", zap.Error(err))
 	}
 
@@ -95,7 +98,7 @@ func createLogsProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Logs) (processor.Logs, error) {
 
-	if err := initNameResolver(cfg, set.Logger); err != nil {
+	if err := initNameResolver(cfg, set.TelemetrySettings); err != nil {
 		set.Logger.Error("failed to initialize name resolver", zap.Error(err))
 	}
 
@@ -110,7 +113,7 @@ func createLogsProcessor(
 		processorhelper.WithShutdown(proc.Shutdown))
 }
 
-func initNameResolver(cfg component.Config, logger *zap.Logger) error {
+func initNameResolver(cfg component.Config, settings component.TelemetrySettings) error {
 	if nameResolver != nil {
 		return nil
 	}
@@ -121,23 +124,36 @@ func initNameResolver(cfg component.Config, logger *zap.Logger) error {
 		return err
 	}
 
+	// only pods on the node of this collector are watched, the pod resources api reports local allocations
+	pods := newPodWatcher(kubeClient, os.Getenv("NODE_NAME"))
 	ns := &NameFromOwner{
-		kc:     kubeClient,
-		logger: logger,
+		kc:      kubeClient,
+		pods:    pods.pods.Lister(),
+		logger:  settings.Logger,
+		extract: pCfg.Extract,
 	}
 
-	kubelet, err := NewKubeletClient(ns)
+	metrics, err := newResolverMetrics(metadata.Meter(settings))
 	if err != nil {
 		return err
 	}
 
-	nameResolver = &NameResolver{
-		logger:                      logger,
-		devicesToResourceAttributes: map[string]*K8sResourceAttributes{},
-		mu:                          sync.RWMutex{},
-		kubelet:                     kubelet,
-		shutdown:                    make(chan struct{}),
+	kubelet, err := NewKubeletClient()
+	if err != nil {
+		return err
+	}
+
+	resolver := NewNameResolver(pCfg, kubelet, ns, metrics, settings.Logger)
+	if err := resolver.Start(); err != nil {
+		kubelet.Close()
+		return err
+	}
+
+	if err := pods.watch(resolver, pCfg.Extract.enabled(), resolver.shutdown); err != nil {
+		resolver.Shutdown()
+		return err
 	}
 
-	return nameResolver.Start()
+	nameResolver = resolver
+	return nil
 }
@@ -19,19 +19,17 @@ var (
 )
 
 type kubeletClient struct {
-	conn         *grpc.ClientConn
-	nameStrategy NameStrategy
+	conn *grpc.ClientConn
 }
 
-func NewKubeletClient(ns NameStrategy) (*kubeletClient, error) {
+func NewKubeletClient() (*kubeletClient, error) {
 	conn, err := connectToKubelet(socketPath)
 	if err != nil {
 		return nil, err
 	}
 
 	return &kubeletClient{
-		conn:         conn,
-		nameStrategy: ns,
+		conn: conn,
 	}, nil
 }
 
@@ -41,24 +39,25 @@ func (c *kubeletClient) Close() {
 	}
 }
 
-func (c *kubeletClient) GetAllocations() (map[string]*K8sResourceAttributes, error) {
+// GetAllocations returns the containers the odigos devices are allocated to, by device id
+func (c *kubeletClient) GetAllocations() (map[string]*ContainerDetails, error) {
 	pods, err := c.listPods()
 	if err != nil {
 		return nil, err
 	}
 
-	allocations := make(map[string]*K8sResourceAttributes)
+	allocations := make(map[string]*ContainerDetails)
 	for _, pod := range pods.GetPodResources() {
 		for _, container := range pod.Containers {
 			for _, device := range container.Devices {
 				for _, id := range device.DeviceIds {
 					if strings.Contains(device.GetResourceName(), "odigos.io") {
-						allocations[id] = c.nameStrategy.GetK8sResourceAttributes(&ContainerDetails{
+						allocations[id] = &ContainerDetails{
 							PodName:         pod.Name,
 							PodNamespace:    pod.Namespace,
 							ContainerName:   container.Name,
 							ContainersInPod: len(pod.Containers),
-						})
+						}
 					}
 				}
 			}
@@ -0,0 +1,55 @@
+package odigosresourcenameprocessor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type resolverMetrics struct {
+	hits      metric.Int64Counter
+	misses    metric.Int64Counter
+	refreshes metric.Int64Counter
+}
+
+func newResolverMetrics(meter metric.Meter) (*resolverMetrics, error) {
+	hits, err := meter.Int64Counter("processor_odigosresourcename_cache_hits",
+		metric.WithDescription("Number of device ids resolved from the cache"),
+		metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	misses, err := meter.Int64Counter("processor_odigosresourcename_cache_misses",
+		metric.WithDescription("Number of device ids not found in the cache, unknown is true when the device id was already known to be missing"),
+		metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	refreshes, err := meter.Int64Counter("processor_odigosresourcename_refreshes",
+		metric.WithDescription("Number of times the device allocations were listed from the kubelet, by trigger"),
+		metric.WithUnit("1"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &resolverMetrics{
+		hits:      hits,
+		misses:    misses,
+		refreshes: refreshes,
+	}, nil
+}
+
+func (m *resolverMetrics) recordHit() {
+	m.hits.Add(context.Background(), 1)
+}
+
+func (m *resolverMetrics) recordMiss(unknown bool) {
+	m.misses.Add(context.Background(), 1, metric.WithAttributes(attribute.Bool("unknown", unknown)))
+}
+
+func (m *resolverMetrics) recordRefresh(trigger string) {
+	m.refreshes.Add(context.Background(), 1, metric.WithAttributes(attribute.String("trigger", trigger)))
+}
@@ -6,43 +6,147 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
 	ErrNoDeviceFound = errors.New("no device found")
 )
 
+const (
+	refreshTriggerMiss     = "miss"
+	refreshTriggerPodEvent = "pod_event"
+	refreshTriggerResync   = "resync"
+
+	// resyncInterval re-lists the allocations in case a pod event was missed
+	resyncInterval = time.Minute
+	// evictionInterval is how often terminated pods and expired unknown devices are evicted
+	evictionInterval = 10 * time.Second
+)
+
+// allocationsLister lists the containers the odigos devices are allocated to, implemented by the kubelet client
+type allocationsLister interface {
+	GetAllocations() (map[string]*ContainerDetails, error)
+	Close()
+}
+
+type deviceEntry struct {
+	details    ContainerDetails
+	attributes *K8sResourceAttributes
+	// terminatedAt is set once the pod is gone, the entry is kept until the TTL passes for telemetry still in flight
+	terminatedAt time.Time
+	// stale entries are resolved again on the next refresh, and served until then
+	stale bool
+}
+
+// NameResolver caches the resource attributes of the odigos devices allocated on this node.
+// The allocations are listed from the kubelet when pods on the node change and on cache misses,
+// device ids the kubelet does not know are cached as unknown so they do not trigger a refresh on every batch.
 type NameResolver struct {
-	logger                      *zap.Logger
-	kubelet                     *kubeletClient
-	mu                          sync.RWMutex
-	devicesToResourceAttributes map[string]*K8sResourceAttributes
-	shutdown                    chan struct{}
-	shutdownOnce                sync.Once
+	logger       *zap.Logger
+	kubelet      allocationsLister
+	nameStrategy NameStrategy
+	metrics      *resolverMetrics
+
+	terminatedPodTTL time.Duration
+	unknownDeviceTTL time.Duration
+
+	mu             sync.RWMutex
+	devices        map[string]*deviceEntry
+	unknownDevices map[string]time.Time
+
+	refreshGroup    singleflight.Group
+	refreshRequests chan struct{}
+	shutdown        chan struct{}
+	shutdownOnce    sync.Once
+	now             func() time.Time
+}
+
+func NewNameResolver(cfg *Config, kubelet allocationsLister, nameStrategy NameStrategy, metrics *resolverMetrics, logger *zap.Logger) *NameResolver {
+	return &NameResolver{
+		logger:           logger,
+		kubelet:          kubelet,
+		nameStrategy:     nameStrategy,
+		metrics:          metrics,
+		terminatedPodTTL: cfg.TerminatedPodTTL,
+		unknownDeviceTTL: cfg.UnknownDeviceTTL,
+		devices:          map[string]*deviceEntry{},
+		unknownDevices:   map[string]time.Time{},
+		refreshRequests:  make(chan struct{}, 1),
+		shutdown:         make(chan struct{}),
+		now:              time.Now,
+	}
 }
 
 func (n *NameResolver) Resolve(deviceID string) (*K8sResourceAttributes, error) {
+	if resourceAttributes, ok := n.lookup(deviceID); ok {
+		n.metrics.recordHit()
+		return resourceAttributes, nil
+	}
+
 	n.mu.RLock()
-	resourceAttributes, ok := n.devicesToResourceAttributes[deviceID]
+	expiry, unknown := n.unknownDevices[deviceID]
 	n.mu.RUnlock()
 
-	if !ok {
-		err := n.updateDevicesToPods()
-		if err != nil {
+	if unknown && n.now().Before(expiry) {
+		n.metrics.recordMiss(true)
+		return &K8sResourceAttributes{}, ErrNoDeviceFound
+	}
+	n.metrics.recordMiss(false)
+
+	shared, err := n.refreshShared(refreshTriggerMiss)
+	if err != nil {
+		n.logger.Error("Error updating devices to pods", zap.Error(err))
+		return &K8sResourceAttributes{}, err
+	}
+
+	if resourceAttributes, ok := n.lookup(deviceID); ok {
+		return resourceAttributes, nil
+	}
+
+	// a shared refresh may have listed the allocations before the pod of the device was created,
+	// it is listed once more before the device is cached as unknown
+	if shared {
+		if _, err := n.refreshShared(refreshTriggerMiss); err != nil {
 			n.logger.Error("Error updating devices to pods", zap.Error(err))
 			return &K8sResourceAttributes{}, err
 		}
+		if resourceAttributes, ok := n.lookup(deviceID); ok {
+			return resourceAttributes, nil
+		}
+	}
 
-		n.mu.RLock()
-		resourceAttributes, ok = n.devicesToResourceAttributes[deviceID]
-		n.mu.RUnlock()
+	n.mu.Lock()
+	n.unknownDevices[deviceID] = n.now().Add(n.unknownDeviceTTL)
+	n.mu.Unlock()
+	return &K8sResourceAttributes{}, ErrNoDeviceFound
+}
 
-		if !ok {
-			return &K8sResourceAttributes{}, ErrNoDeviceFound
-		}
+func (n *NameResolver) lookup(deviceID string) (*K8sResourceAttributes, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	entry, ok := n.devices[deviceID]
+	if !ok {
+		return nil, false
 	}
+	return entry.attributes, true
+}
 
-	return resourceAttributes, nil
+// refresh lists the allocations from the kubelet, concurrent callers wait for the same request
+func (n *NameResolver) refresh(trigger string) error {
+	_, err := n.refreshShared(trigger)
+	return err
+}
+
+// refreshShared is refresh, and tells if the request was shared with other callers,
+// in which case it may have started before this caller asked for it
+func (n *NameResolver) refreshShared(trigger string) (bool, error) {
+	_, err, shared := n.refreshGroup.Do("allocations", func() (interface{}, error) {
+		n.metrics.recordRefresh(trigger)
+		return nil, n.updateDevicesToPods()
+	})
+	return shared, err
 }
 
 func (n *NameResolver) updateDevicesToPods() error {
@@ -51,27 +155,115 @@ func (n *NameResolver) updateDevicesToPods() error {
 		return err
 	}
 
+	// only new allocations are resolved, outside the lock as it queries the api server
+	n.mu.RLock()
+	resolved := make(map[string]*K8sResourceAttributes)
+	for id, details := range allocations {
+		if entry, ok := n.devices[id]; !ok || entry.stale || entry.details != *details {
+			resolved[id] = nil
+		}
+	}
+	n.mu.RUnlock()
+
+	for id := range resolved {
+		resolved[id] = n.nameStrategy.GetK8sResourceAttributes(allocations[id])
+	}
+
+	now := n.now()
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
-	n.devicesToResourceAttributes = allocations
+	for id, resourceAttributes := range resolved {
+		n.devices[id] = &deviceEntry{details: *allocations[id], attributes: resourceAttributes}
+		delete(n.unknownDevices, id)
+	}
+	for id, entry := range n.devices {
+		if _, ok := allocations[id]; ok {
+			// a device listed again is live, even if it was seen as terminated before
+			entry.terminatedAt = time.Time{}
+		} else if entry.terminatedAt.IsZero() {
+			entry.terminatedAt = now
+		}
+	}
 	return nil
 }
 
+// requestRefresh asks for the allocations to be listed, requests made while one is pending are coalesced
+func (n *NameResolver) requestRefresh() {
+	select {
+	case n.refreshRequests <- struct{}{}:
+	default:
+	}
+}
+
+// markPodTerminated starts the TTL of the devices allocated to the pod
+func (n *NameResolver) markPodTerminated(namespace string, name string) {
+	now := n.now()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, entry := range n.devices {
+		if entry.details.PodNamespace == namespace && entry.details.PodName == name && entry.terminatedAt.IsZero() {
+			entry.terminatedAt = now
+		}
+	}
+}
+
+// markPodChanged resolves the devices allocated to the pod again, after its labels or annotations changed
+func (n *NameResolver) markPodChanged(namespace string, name string) {
+	n.mu.Lock()
+	for _, entry := range n.devices {
+		if entry.details.PodNamespace == namespace && entry.details.PodName == name {
+			entry.stale = true
+		}
+	}
+	n.mu.Unlock()
+
+	n.requestRefresh()
+}
+
+func (n *NameResolver) evict() {
+	now := n.now()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for id, entry := range n.devices {
+		if !entry.terminatedAt.IsZero() && now.Sub(entry.terminatedAt) >= n.terminatedPodTTL {
+			delete(n.devices, id)
+		}
+	}
+	for id, expiry := range n.unknownDevices {
+		if !now.Before(expiry) {
+			delete(n.unknownDevices, id)
+		}
+	}
+}
+
 func (n *NameResolver) Start() error {
 	n.logger.Info("Starting NameResolver ...")
+	if err := n.refresh(refreshTriggerResync); err != nil {
+		n.logger.Error("Error updating devices to pods", zap.Error(err))
+	}
+
 	go func() {
-		// Refresh devices to pods every 5 seconds
-		ticker := time.NewTicker(5 * time.Second)
+		resync := time.NewTicker(resyncInterval)
+		defer resync.Stop()
+		eviction := time.NewTicker(evictionInterval)
+		defer eviction.Stop()
+
 		for {
 			select {
-			case <-ticker.C:
-				err := n.updateDevicesToPods()
-				if err != nil {
+			case <-n.refreshRequests:
+				if err := n.refresh(refreshTriggerPodEvent); err != nil {
+					n.logger.Error("Error updating devices to pods", zap.Error(err))
+				}
+			case <-resync.C:
+				if err := n.refresh(refreshTriggerResync); err != nil {
 					n.logger.Error("Error updating devices to pods", zap.Error(err))
 				}
+			case <-eviction.C:
+				n.evict()
 			case <-n.shutdown:
-				ticker.Stop()
 				return
 			}
 		}
@@ -84,5 +276,6 @@ func (n *NameResolver) Shutdown() {
 	n.logger.Info("Shutting down NameResolver ...")
 	n.shutdownOnce.Do(func() {
 		close(n.shutdown)
+		n.kubelet.Close()
 	})
 }
@@ -0,0 +1,223 @@
+package odigosresourcenameprocessor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+)
+
+type fakeKubelet struct {
+	mu          sync.Mutex
+	allocations map[string]*ContainerDetails
+	calls       int
+	// when set, the next listing is returned once it is closed, and listed is closed when the allocations are read
+	release chan struct{}
+	listed  chan struct{}
+}
+
+func (f *fakeKubelet) GetAllocations() (map[string]*ContainerDetails, error) {
+	f.mu.Lock()
+	f.calls++
+	allocations := make(map[string]*ContainerDetails, len(f.allocations))
+	for id, details := range f.allocations {
+		allocations[id] = details
+	}
+	release, listed := f.release, f.listed
+	f.release, f.listed = nil, nil
+	f.mu.Unlock()
+
+	if release != nil {
+		close(listed)
+		<-release
+	}
+	return allocations, nil
+}
+
+func (f *fakeKubelet) Close() {}
+
+func (f *fakeKubelet) setAllocations(allocations map[string]*ContainerDetails) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.allocations = allocations
+}
+
+func (f *fakeKubelet) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+type fakeNameStrategy struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (f *fakeNameStrategy) GetK8sResourceAttributes(containerDetails *ContainerDetails) *K8sResourceAttributes {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+
+	return &K8sResourceAttributes{
+		OtelServiceName: containerDetails.PodName,
+		Namespace:       containerDetails.PodNamespace,
+		PodName:         containerDetails.PodName,
+		ContainerName:   containerDetails.ContainerName,
+	}
+}
+
+func newTestResolver(t *testing.T, kubelet *fakeKubelet, ns NameStrategy) (*NameResolver, *time.Time) {
+	metrics, err := newResolverMetrics(noop.NewMeterProvider().Meter("test"))
+	require.NoError(t, err)
+
+	cfg := createDefaultConfig().(*Config)
+	resolver := NewNameResolver(cfg, kubelet, ns, metrics, zap.NewNop())
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+	return resolver, &now
+}
+
+func TestResolveCachesAllocations(t *testing.T) {
+	kubelet := &fakeKubelet{allocations: map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+	}}
+	ns := &fakeNameStrategy{}
+	resolver, _ := newTestResolver(t, kubelet, ns)
+
+	for i := 0; i < 3; i++ {
+		attrs, err := resolver.Resolve("device-1")
+		require.NoError(t, err)
+		assert.Equal(t, "frontend-1", attrs.OtelServiceName)
+	}
+	assert.Equal(t, 1, kubelet.callCount())
+
+	// unchanged allocations are not resolved again
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+	assert.Equal(t, 1, ns.calls)
+}
+
+func TestResolveCachesUnknownDevices(t *testing.T) {
+	kubelet := &fakeKubelet{allocations: map[string]*ContainerDetails{}}
+	resolver, now := newTestResolver(t, kubelet, &fakeNameStrategy{})
+
+	for i := 0; i < 3; i++ {
+		_, err := resolver.Resolve("device-1")
+		assert.ErrorIs(t, err, ErrNoDeviceFound)
+	}
+	assert.Equal(t, 1, kubelet.callCount())
+
+	// a refresh that finds the device clears the unknown entry
+	kubelet.setAllocations(map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+	})
+	require.NoError(t, resolver.refresh(refreshTriggerPodEvent))
+	_, err := resolver.Resolve("device-1")
+	require.NoError(t, err)
+
+	// unknown entries expire
+	_, err = resolver.Resolve("device-2")
+	assert.ErrorIs(t, err, ErrNoDeviceFound)
+	calls := kubelet.callCount()
+	*now = now.Add(resolver.unknownDeviceTTL)
+	_, err = resolver.Resolve("device-2")
+	assert.ErrorIs(t, err, ErrNoDeviceFound)
+	assert.Equal(t, calls+1, kubelet.callCount())
+}
+
+func TestResolveRefreshesAgainAfterSharedRefresh(t *testing.T) {
+	release, listed := make(chan struct{}), make(chan struct{})
+	kubelet := &fakeKubelet{allocations: map[string]*ContainerDetails{}, release: release, listed: listed}
+	resolver, _ := newTestResolver(t, kubelet, &fakeNameStrategy{})
+
+	// a refresh lists the allocations before the pod of the device is created
+	refreshed := make(chan error)
+	go func() { refreshed <- resolver.refresh(refreshTriggerPodEvent) }()
+	<-listed
+	kubelet.setAllocations(map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+	})
+
+	// the miss joins the refresh in flight, which does not know the device
+	resolved := make(chan error)
+	go func() {
+		_, err := resolver.Resolve("device-1")
+		resolved <- err
+	}()
+	// there is no hook in singleflight to tell the miss joined, it is given the time to
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	require.NoError(t, <-refreshed)
+	require.NoError(t, <-resolved)
+	assert.Equal(t, 2, kubelet.callCount())
+	resolver.mu.RLock()
+	assert.NotContains(t, resolver.unknownDevices, "device-1")
+	resolver.mu.RUnlock()
+}
+
+func TestResolveEvictsTerminatedPods(t *testing.T) {
+	kubelet := &fakeKubelet{allocations: map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+		"device-2": {PodName: "cart-1", PodNamespace: "default", ContainerName: "cart", ContainersInPod: 1},
+	}}
+	resolver, now := newTestResolver(t, kubelet, &fakeNameStrategy{})
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+
+	resolver.markPodTerminated("default", "frontend-1")
+	kubelet.setAllocations(map[string]*ContainerDetails{})
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+
+	// terminated pods still resolve until the TTL passes
+	*now = now.Add(resolver.terminatedPodTTL / 2)
+	resolver.evict()
+	_, err := resolver.Resolve("device-1")
+	require.NoError(t, err)
+
+	*now = now.Add(resolver.terminatedPodTTL / 2)
+	resolver.evict()
+	_, ok := resolver.lookup("device-1")
+	assert.False(t, ok)
+	_, ok = resolver.lookup("device-2")
+	assert.False(t, ok)
+}
+
+func TestResolveKeepsDevicesListedAgain(t *testing.T) {
+	allocations := map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+	}
+	kubelet := &fakeKubelet{allocations: allocations}
+	resolver, now := newTestResolver(t, kubelet, &fakeNameStrategy{})
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+
+	kubelet.setAllocations(map[string]*ContainerDetails{})
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+
+	// the device is listed again with the same details, so it is not evicted once the TTL passes
+	kubelet.setAllocations(allocations)
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+	*now = now.Add(resolver.terminatedPodTTL)
+	resolver.evict()
+	_, ok := resolver.lookup("device-1")
+	assert.True(t, ok)
+}
+
+func TestResolveRefreshesChangedPods(t *testing.T) {
+	kubelet := &fakeKubelet{allocations: map[string]*ContainerDetails{
+		"device-1": {PodName: "frontend-1", PodNamespace: "default", ContainerName: "frontend", ContainersInPod: 1},
+	}}
+	ns := &fakeNameStrategy{}
+	resolver, _ := newTestResolver(t, kubelet, ns)
+	require.NoError(t, resolver.refresh(refreshTriggerResync))
+
+	resolver.markPodChanged("default", "frontend-1")
+	_, err := resolver.Resolve("device-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, ns.calls)
+
+	require.NoError(t, resolver.refresh(refreshTriggerPodEvent))
+	assert.Equal(t, 2, ns.calls)
+}
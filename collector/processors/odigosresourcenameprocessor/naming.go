@@ -4,8 +4,11 @@ import (
 	"context"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 )
 
 type ContainerDetails struct {
@@ -22,6 +25,8 @@ type K8sResourceAttributes struct {
 	WorkloadName    string
 	PodName         string
 	ContainerName   string
+	// PodAttributes holds the resource attributes extracted from the pod labels and annotations
+	PodAttributes map[string]string
 }
 
 type NameStrategy interface {
@@ -29,8 +34,11 @@ type NameStrategy interface {
 }
 
 type NameFromOwner struct {
-	kc     kubernetes.Interface
-	logger *zap.Logger
+	kc kubernetes.Interface
+	// pods lists the pods on the node from the pod watcher cache
+	pods    corelisters.PodLister
+	logger  *zap.Logger
+	extract ExtractConfig
 }
 
 func (n *NameFromOwner) GetK8sResourceAttributes(containerDetails *ContainerDetails) *K8sResourceAttributes {
@@ -41,12 +49,31 @@ func (n *NameFromOwner) GetK8sResourceAttributes(containerDetails *ContainerDeta
 		ContainerName: containerDetails.ContainerName,
 	}
 
+	// the pod is needed for its owner, and for its labels and annotations when they are extracted
+	var (
+		pod *corev1.Pod
+		err error
+	)
+	if containerDetails.ContainersInPod <= 1 || n.extract.enabled() {
+		pod, err = n.getPod(containerDetails.PodNamespace, containerDetails.PodName)
+		if err != nil {
+			n.logger.Error("Failed to get pod", zap.Error(err))
+		} else {
+			attrs.PodAttributes = extractPodAttributes(n.extract, pod.GetLabels(), pod.GetAnnotations())
+		}
+	}
+
 	if containerDetails.ContainersInPod > 1 {
 		attrs.OtelServiceName = containerDetails.ContainerName
 		return &attrs
 	}
 
-	workloadName, workloadKind, err := n.getWorkloadNameByOwner(containerDetails)
+	if err != nil {
+		attrs.OtelServiceName = containerDetails.PodName
+		return &attrs
+	}
+
+	workloadName, workloadKind, err := n.getWorkloadNameByOwner(pod)
 	if err != nil {
 		n.logger.Error("Failed to get name by owner, using pod name", zap.Error(err))
 		attrs.OtelServiceName = containerDetails.PodName
@@ -66,6 +93,22 @@ func (n *NameFromOwner) GetK8sResourceAttributes(containerDetails *ContainerDeta
 	return &attrs
 }
 
+// getPod reads the pod from the cache of the pods on the node,
+// and from the api server when it is not cached yet, e.g. right after the collector started.
+func (n *NameFromOwner) getPod(namespace string, name string) (*corev1.Pod, error) {
+	if n.pods != nil {
+		pod, err := n.pods.Pods(namespace).Get(name)
+		if err == nil {
+			return pod, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return n.kc.CoreV1().Pods(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
 func (n *NameFromOwner) getServiceNameFromAnnotation(name string, kind string, namespace string) (string, bool) {
 	obj := n.getKubeObject(name, kind, namespace)
 	if obj == nil {
@@ -112,7 +155,7 @@ func (n *NameFromOwner) getKubeObject(name string, kind string, namespace string
 
 		return daemonSet
 	case "Pod":
-		pod, err := n.kc.CoreV1().Pods(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		pod, err := n.getPod(namespace, name)
 		if err != nil {
 			n.logger.Error("Failed to get pod", zap.Error(err))
 			return nil
@@ -124,13 +167,7 @@ func (n *NameFromOwner) getKubeObject(name string, kind string, namespace string
 	return nil
 }
 
-func (n *NameFromOwner) getWorkloadNameByOwner(containerDetails *ContainerDetails) (workloadName string, workloadKind string, err error) {
-	pod, err := n.kc.CoreV1().Pods(containerDetails.PodNamespace).
-		Get(context.Background(), containerDetails.PodName, metav1.GetOptions{})
-	if err != nil {
-		return "", "", err
-	}
-
+func (n *NameFromOwner) getWorkloadNameByOwner(pod *corev1.Pod) (workloadName string, workloadKind string, err error) {
 	ownerRefs := pod.GetOwnerReferences()
 	for _, ownerRef := range ownerRefs {
 		if ownerRef.Kind == "ReplicaSet" {
@@ -150,5 +187,5 @@ func (n *NameFromOwner) getWorkloadNameByOwner(containerDetails *ContainerDetail
 		}
 	}
 
-	return containerDetails.PodName, "Pod", nil
+	return pod.Name, "Pod", nil
 }
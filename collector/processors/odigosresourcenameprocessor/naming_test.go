@@ -0,0 +1,80 @@
+package odigosresourcenameprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newCachedPod(t *testing.T, pod *corev1.Pod) corelisters.PodLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(pod))
+	return corelisters.NewPodLister(indexer)
+}
+
+func TestNameFromOwnerReadsPodFromCache(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ds-abcde",
+			Namespace: "default",
+			Labels:    map[string]string{"team": "payments"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "DaemonSet", Name: "ds"},
+			},
+		},
+	}
+	kc := fake.NewSimpleClientset()
+	n := &NameFromOwner{
+		kc:      kc,
+		pods:    newCachedPod(t, pod),
+		logger:  zap.NewNop(),
+		extract: ExtractConfig{Labels: []FieldExtractConfig{{Key: "team"}}},
+	}
+
+	attrs := n.GetK8sResourceAttributes(&ContainerDetails{PodName: "ds-abcde", PodNamespace: "default", ContainersInPod: 1, ContainerName: "app"})
+	assert.Equal(t, "DaemonSet", attrs.WorkloadKind)
+	assert.Equal(t, "ds", attrs.WorkloadName)
+	assert.Equal(t, "payments", attrs.PodAttributes[podLabelsAttributePrefix+"team"])
+
+	// only the daemonset is fetched for its reported name annotation, the pod comes from the cache
+	for _, action := range kc.Actions() {
+		assert.NotEqual(t, "pods", action.GetResource().Resource)
+	}
+}
+
+func TestNameFromOwnerSkipsPodWithoutExtraction(t *testing.T) {
+	kc := fake.NewSimpleClientset()
+	n := &NameFromOwner{
+		kc:     kc,
+		pods:   newCachedPod(t, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}),
+		logger: zap.NewNop(),
+	}
+
+	// the service of a multi container pod is named after the container, the pod is not needed
+	attrs := n.GetK8sResourceAttributes(&ContainerDetails{PodName: "app-abcde", PodNamespace: "default", ContainersInPod: 2, ContainerName: "sidecar"})
+	assert.Equal(t, "sidecar", attrs.OtelServiceName)
+	assert.Nil(t, attrs.PodAttributes)
+	assert.Empty(t, kc.Actions())
+}
+
+func TestNameFromOwnerFallsBackToApiServer(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default"}}
+	kc := fake.NewSimpleClientset(pod)
+	n := &NameFromOwner{
+		kc:     kc,
+		pods:   newCachedPod(t, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}),
+		logger: zap.NewNop(),
+	}
+
+	// a pod not cached yet is read from the api server
+	attrs := n.GetK8sResourceAttributes(&ContainerDetails{PodName: "standalone", PodNamespace: "default", ContainersInPod: 1, ContainerName: "app"})
+	assert.Equal(t, "Pod", attrs.WorkloadKind)
+	assert.Equal(t, "standalone", attrs.OtelServiceName)
+}
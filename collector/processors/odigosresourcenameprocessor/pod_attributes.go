@@ -0,0 +1,51 @@
+package odigosresourcenameprocessor
+
+import "strings"
+
+const (
+	podLabelsAttributePrefix      = "k8s.pod.labels."
+	podAnnotationsAttributePrefix = "k8s.pod.annotations."
+)
+
+// extractPodAttributes returns the resource attributes copied from the pod labels and annotations by the extract rules
+func extractPodAttributes(extract ExtractConfig, labels map[string]string, annotations map[string]string) map[string]string {
+	if !extract.enabled() {
+		return nil
+	}
+
+	attributes := map[string]string{}
+	extractFields(extract.Labels, labels, podLabelsAttributePrefix, attributes)
+	extractFields(extract.Annotations, annotations, podAnnotationsAttributePrefix, attributes)
+	return attributes
+}
+
+func extractFields(rules []FieldExtractConfig, fields map[string]string, defaultPrefix string, attributes map[string]string) {
+	for _, rule := range rules {
+		if rule.Key != "" {
+			value, ok := fields[rule.Key]
+			if !ok {
+				continue
+			}
+
+			name := rule.TagName
+			if name == "" {
+				name = defaultPrefix + rule.Key
+			}
+			attributes[name] = value
+			continue
+		}
+
+		for key, value := range fields {
+			if !strings.HasPrefix(key, rule.KeyPrefix) {
+				continue
+			}
+
+			// the tag name replaces the prefix, so the rest of the key is kept
+			name := defaultPrefix + key
+			if rule.TagName != "" {
+				name = rule.TagName + strings.TrimPrefix(key, rule.KeyPrefix)
+			}
+			attributes[name] = value
+		}
+	}
+}
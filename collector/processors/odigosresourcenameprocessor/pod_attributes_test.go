@@ -0,0 +1,56 @@
+package odigosresourcenameprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractPodAttributes(t *testing.T) {
+	extract := ExtractConfig{
+		Labels: []FieldExtractConfig{
+			{Key: "team", TagName: "team"},
+			{Key: "cost-center"},
+			{KeyPrefix: "app.kubernetes.io/", TagName: "app."},
+			{Key: "missing"},
+		},
+		Annotations: []FieldExtractConfig{
+			{KeyPrefix: "odigos.io/"},
+		},
+	}
+	labels := map[string]string{
+		"team":                      "checkout",
+		"cost-center":               "cc-42",
+		"app.kubernetes.io/version": "1.2.3",
+		"app.kubernetes.io/name":    "frontend",
+		"pod-template-hash":         "5d8f7c9b4",
+	}
+	annotations := map[string]string{
+		"odigos.io/reported-name":           "storefront",
+		"kubectl.kubernetes.io/restartedAt": "2024-03-01T10:00:00Z",
+	}
+
+	assert.Equal(t, map[string]string{
+		"team":                       "checkout",
+		"k8s.pod.labels.cost-center": "cc-42",
+		"app.version":                "1.2.3",
+		"app.name":                   "frontend",
+		"k8s.pod.annotations.odigos.io/reported-name": "storefront",
+	}, extractPodAttributes(extract, labels, annotations))
+}
+
+func TestExtractPodAttributesDisabled(t *testing.T) {
+	assert.Nil(t, extractPodAttributes(ExtractConfig{}, map[string]string{"team": "checkout"}, nil))
+}
+
+func TestValidateExtractConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Extract.Labels = []FieldExtractConfig{{Key: "team", KeyPrefix: "app.kubernetes.io/"}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Extract.Labels = []FieldExtractConfig{{TagName: "team"}}
+	assert.Error(t, cfg.Validate())
+
+	cfg.Extract.Labels = []FieldExtractConfig{{Key: "team"}, {KeyPrefix: "app.kubernetes.io/"}}
+	assert.NoError(t, cfg.Validate())
+}
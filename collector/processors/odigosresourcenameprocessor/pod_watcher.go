@@ -0,0 +1,86 @@
+package odigosresourcenameprocessor
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// podWatcher caches the pods on the node, so resolving names does not query the api server for every pod
+type podWatcher struct {
+	factory informers.SharedInformerFactory
+	pods    coreinformers.PodInformer
+}
+
+func newPodWatcher(kc kubernetes.Interface, nodeName string) *podWatcher {
+	factory := informers.NewSharedInformerFactoryWithOptions(kc, 0, informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+		if nodeName != "" {
+			opts.FieldSelector = fields.OneTermEqualSelector("spec.nodeName", nodeName).String()
+		}
+	}))
+
+	return &podWatcher{
+		factory: factory,
+		pods:    factory.Core().V1().Pods(),
+	}
+}
+
+// watch notifies the resolver of changes to the pods on the node, so allocations are listed when pods
+// start instead of polling the kubelet, and the devices of terminated pods are evicted.
+// When pod labels and annotations are extracted, pods whose labels or annotations change are resolved again.
+func (w *podWatcher) watch(resolver *NameResolver, extractMetadata bool, stopCh <-chan struct{}) error {
+	_, err := w.pods.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			resolver.requestRefresh()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, ok := oldObj.(*corev1.Pod)
+			if !ok {
+				return
+			}
+			newPod, ok := newObj.(*corev1.Pod)
+			if !ok {
+				return
+			}
+
+			if isPodTerminated(newPod) {
+				resolver.markPodTerminated(newPod.Namespace, newPod.Name)
+				return
+			}
+			if extractMetadata && (!reflect.DeepEqual(oldPod.Labels, newPod.Labels) || !reflect.DeepEqual(oldPod.Annotations, newPod.Annotations)) {
+				resolver.markPodChanged(newPod.Namespace, newPod.Name)
+				return
+			}
+			// devices are allocated when the containers are created, after the pod was added
+			if oldPod.Status.Phase != newPod.Status.Phase {
+				resolver.requestRefresh()
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				return
+			}
+			resolver.markPodTerminated(pod.Namespace, pod.Name)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	w.factory.Start(stopCh)
+	return nil
+}
+
+func isPodTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
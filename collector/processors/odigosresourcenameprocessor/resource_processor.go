@@ -73,6 +73,9 @@ func (rp *resourceProcessor) processAttributes(ctx context.Context, logger *zap.
 		if resourceAttributes.ContainerName != "" {
 			attrs.PutStr(string(semconv.ContainerNameKey), resourceAttributes.ContainerName)
 		}
+		for key, value := range resourceAttributes.PodAttributes {
+			attrs.PutStr(key, value)
+		}
 		return
 	}
 }
@@ -114,6 +117,9 @@ func (rp *resourceProcessor) processLogs(ctx context.Context, ld plog.Logs) (plo
 }
 
 func (rp *resourceProcessor) Shutdown(ctx context.Context) error {
-	rp.nameResolver.Shutdown()
+	// the name resolver is not created when the processor is shut down before it started
+	if rp.nameResolver != nil {
+		rp.nameResolver.Shutdown()
+	}
 	return nil
 }
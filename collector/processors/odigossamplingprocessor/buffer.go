@@ -0,0 +1,168 @@
+package odigossamplingprocessor
+
+import (
+	"container/list"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// bufferedTrace holds the spans of a trace received so far
+type bufferedTrace struct {
+	traceID pcommon.TraceID
+	td      ptrace.Traces
+	// arrival is the time the first span of the trace was received
+	arrival time.Time
+}
+
+// traceBuffer holds traces in memory until they are evaluated, in the arrival order of their first span.
+// It is not safe for concurrent use.
+type traceBuffer struct {
+	maxTraces int
+	spans     int
+	traces    map[pcommon.TraceID]*list.Element
+	order     *list.List
+}
+
+func newTraceBuffer(maxTraces int) *traceBuffer {
+	return &traceBuffer{
+		maxTraces: maxTraces,
+		traces:    make(map[pcommon.TraceID]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// add appends the spans to their buffered trace. When a new trace does not fit in the buffer,
+// the oldest trace is removed and returned so it can be evaluated early.
+func (tb *traceBuffer) add(traceID pcommon.TraceID, td ptrace.Traces, now time.Time) (evicted *bufferedTrace) {
+	tb.spans += td.SpanCount()
+
+	if element, found := tb.traces[traceID]; found {
+		td.ResourceSpans().MoveAndAppendTo(element.Value.(*bufferedTrace).td.ResourceSpans())
+		return nil
+	}
+
+	if tb.order.Len() >= tb.maxTraces {
+		evicted = tb.remove(tb.order.Front())
+	}
+	tb.traces[traceID] = tb.order.PushBack(&bufferedTrace{traceID: traceID, td: td, arrival: now})
+	return evicted
+}
+
+// popExpired removes and returns the traces whose first span arrived at or before the deadline
+func (tb *traceBuffer) popExpired(deadline time.Time) []*bufferedTrace {
+	var expired []*bufferedTrace
+	for element := tb.order.Front(); element != nil; element = tb.order.Front() {
+		if element.Value.(*bufferedTrace).arrival.After(deadline) {
+			break
+		}
+		expired = append(expired, tb.remove(element))
+	}
+	return expired
+}
+
+// popAll removes and returns all the buffered traces
+func (tb *traceBuffer) popAll() []*bufferedTrace {
+	all := make([]*bufferedTrace, 0, tb.order.Len())
+	for element := tb.order.Front(); element != nil; element = tb.order.Front() {
+		all = append(all, tb.remove(element))
+	}
+	return all
+}
+
+func (tb *traceBuffer) remove(element *list.Element) *bufferedTrace {
+	trace := tb.order.Remove(element).(*bufferedTrace)
+	delete(tb.traces, trace.traceID)
+	tb.spans -= trace.td.SpanCount()
+	return trace
+}
+
+func (tb *traceBuffer) len() int {
+	return tb.order.Len()
+}
+
+// decisionCache remembers the decisions of the most recent traces, replacing the oldest decision when full.
+// It is not safe for concurrent use.
+type decisionCache struct {
+	decisions map[pcommon.TraceID]samplingDecision
+	// ring of the cached trace ids, once full next points at the oldest one
+	ring []pcommon.TraceID
+	next int
+}
+
+func newDecisionCache(size int) *decisionCache {
+	return &decisionCache{
+		decisions: make(map[pcommon.TraceID]samplingDecision),
+		ring:      make([]pcommon.TraceID, 0, size),
+	}
+}
+
+func (dc *decisionCache) get(traceID pcommon.TraceID) (samplingDecision, bool) {
+	decision, found := dc.decisions[traceID]
+	return decision, found
+}
+
+func (dc *decisionCache) put(traceID pcommon.TraceID, decision samplingDecision) {
+	if cap(dc.ring) == 0 {
+		return
+	}
+	if _, found := dc.decisions[traceID]; found {
+		dc.decisions[traceID] = decision
+		return
+	}
+
+	if len(dc.ring) < cap(dc.ring) {
+		dc.ring = append(dc.ring, traceID)
+	} else {
+		delete(dc.decisions, dc.ring[dc.next])
+		dc.ring[dc.next] = traceID
+		dc.next = (dc.next + 1) % len(dc.ring)
+	}
+	dc.decisions[traceID] = decision
+}
+
+// splitByTraceID splits a batch into one ptrace.Traces per trace id, keeping the resource and scope of every span
+func splitByTraceID(td ptrace.Traces) map[pcommon.TraceID]ptrace.Traces {
+	traces := make(map[pcommon.TraceID]ptrace.Traces)
+
+	resources := td.ResourceSpans()
+	for r := 0; r < resources.Len(); r++ {
+		rs := resources.At(r)
+		resourceCopies := make(map[pcommon.TraceID]ptrace.ResourceSpans)
+
+		scopes := rs.ScopeSpans()
+		for j := 0; j < scopes.Len(); j++ {
+			ss := scopes.At(j)
+			scopeCopies := make(map[pcommon.TraceID]ptrace.ScopeSpans)
+
+			spans := ss.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				traceID := span.TraceID()
+
+				scopeCopy, found := scopeCopies[traceID]
+				if !found {
+					resourceCopy, found := resourceCopies[traceID]
+					if !found {
+						trace, found := traces[traceID]
+						if !found {
+							trace = ptrace.NewTraces()
+							traces[traceID] = trace
+						}
+						resourceCopy = trace.ResourceSpans().AppendEmpty()
+						rs.Resource().CopyTo(resourceCopy.Resource())
+						resourceCopy.SetSchemaUrl(rs.SchemaUrl())
+						resourceCopies[traceID] = resourceCopy
+					}
+					scopeCopy = resourceCopy.ScopeSpans().AppendEmpty()
+					ss.Scope().CopyTo(scopeCopy.Scope())
+					scopeCopy.SetSchemaUrl(ss.SchemaUrl())
+					scopeCopies[traceID] = scopeCopy
+				}
+				span.CopyTo(scopeCopy.Spans().AppendEmpty())
+			}
+		}
+	}
+	return traces
+}
@@ -3,6 +3,7 @@ package odigossamplingprocessor
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossamplingprocessor/internal/sampling"
@@ -13,14 +14,41 @@ type Config struct {
 	GlobalRules   []Rule `mapstructure:"global_rules,omitempty"`
 	ServiceRules  []Rule `mapstructure:"service_rules,omitempty"`
 	EndpointRules []Rule `mapstructure:"endpoint_rules,omitempty"`
+
+	// DecisionWait is how long the spans of a trace are held in memory, from its first span, before the rules are evaluated
+	DecisionWait time.Duration `mapstructure:"decision_wait"`
+	// NumTraces is the maximum number of traces held in memory, the oldest trace is evaluated early when the limit is reached
+	NumTraces int `mapstructure:"num_traces"`
+	// DecisionCacheSize is the number of recent decisions remembered, so spans arriving after their trace was evaluated get the same decision
+	DecisionCacheSize int `mapstructure:"decision_cache_size"`
 }
 
 var _ component.Config = (*Config)(nil)
 
 func (cfg *Config) Validate() error {
-	for _, rule := range cfg.EndpointRules { // TODO, validate all rules [Global/Service]
-		if err := rule.Validate(); err != nil {
-			return err
+	if cfg.DecisionWait <= 0 {
+		return errors.New("decision wait must be positive")
+	}
+	if cfg.NumTraces <= 0 {
+		return errors.New("num traces must be positive")
+	}
+	if cfg.DecisionCacheSize < 0 {
+		return errors.New("decision cache size cannot be negative")
+	}
+
+	// adaptive rules target the throughput of services, they apply to all the spans or to a service
+	for _, rule := range cfg.EndpointRules {
+		if rule.Type == "adaptive" {
+			return fmt.Errorf("adaptive rule %s is not supported in endpoint rules, use a global or service rule", rule.Name)
+		}
+	}
+
+	for _, rules := range [][]Rule{cfg.GlobalRules, cfg.ServiceRules, cfg.EndpointRules} {
+		// iterate by index, Validate replaces the raw rule details with the decoded rule
+		for i := range rules {
+			if err := rules[i].Validate(); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -53,6 +81,33 @@ func (r *Rule) Validate() error {
 			return err
 		}
 		r.RuleDetails = &details
+	case "span_attribute":
+		var details sampling.SpanAttributeRule
+		if err := mapstructure.Decode(r.RuleDetails, &details); err != nil {
+			return err
+		}
+		if err := details.Validate(); err != nil {
+			return err
+		}
+		r.RuleDetails = &details
+	case "adaptive":
+		var details sampling.AdaptiveRule
+		if err := mapstructure.Decode(r.RuleDetails, &details); err != nil {
+			return err
+		}
+		if err := details.Validate(); err != nil {
+			return err
+		}
+		r.RuleDetails = &details
+	case "service_name":
+		var details sampling.ServiceNameRule
+		if err := mapstructure.Decode(r.RuleDetails, &details); err != nil {
+			return err
+		}
+		if err := details.Validate(); err != nil {
+			return err
+		}
+		r.RuleDetails = &details
 	case "error":
 		var details sampling.ErrorRule
 		if err := mapstructure.Decode(r.RuleDetails, &details); err != nil {
@@ -2,11 +2,11 @@ package odigossamplingprocessor
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/processor"
-	"go.opentelemetry.io/collector/processor/processorhelper"
 )
 
 // NewFactory returns a new factory for the Resource processor.
@@ -18,11 +18,20 @@ func NewFactory() processor.Factory {
 	)
 }
 
+const (
+	defaultDecisionWait      = 30 * time.Second
+	defaultNumTraces         = 50000
+	defaultDecisionCacheSize = 100000
+)
+
 func createDefaultConfig() component.Config {
 	return &Config{
-		GlobalRules:   []Rule{},
-		ServiceRules:  []Rule{},
-		EndpointRules: []Rule{},
+		GlobalRules:       []Rule{},
+		ServiceRules:      []Rule{},
+		EndpointRules:     []Rule{},
+		DecisionWait:      defaultDecisionWait,
+		NumTraces:         defaultNumTraces,
+		DecisionCacheSize: defaultDecisionCacheSize,
 	}
 }
 
@@ -32,14 +41,14 @@ func createTracesProcessor(
 	cfg component.Config,
 	nextConsumer consumer.Traces) (processor.Traces, error) {
 
-	proc := &samplingProcessor{logger: set.Logger, config: cfg.(*Config)}
+	config := cfg.(*Config)
+	proc := newSamplingProcessor(set.Logger, config, nextConsumer)
 
-	return processorhelper.NewTracesProcessor(
-		ctx,
-		set,
-		cfg,
-		nextConsumer,
-		proc.processTraces,
-		processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: true}),
-	)
+	telemetry, err := newSamplingTelemetry(set.TelemetrySettings, proc.bufferUsage, proc.adaptiveRatios)
+	if err != nil {
+		return nil, err
+	}
+	proc.telemetry = telemetry
+
+	return proc, nil
 }
@@ -0,0 +1,228 @@
+package sampling
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+const (
+	defaultAdjustmentInterval = 10 * time.Second
+	defaultMinSamplingRatio   = 0.1
+	// weight of the previous observed throughput when smoothing, so a single spiky interval does not swing the ratio
+	throughputSmoothing = 0.5
+	// services not seen for this many adjustment intervals are forgotten, so churning service names do not grow the memory
+	idleIntervalsBeforeEviction = 6
+	// the most services a rule keeps state for, the least recently seen one is forgotten to make room for a new one
+	defaultMaxTrackedServices = 10000
+)
+
+// AdaptiveRule continuously adjusts the sampling ratio of each service, so the number of
+// traces kept per second stays around the target regardless of the incoming throughput.
+// The throughput of the services is tracked by the AdaptiveSampler of the rule.
+type AdaptiveRule struct {
+	// when empty, every service gets its own target
+	ServiceName           string  `mapstructure:"service_name"`
+	TargetTracesPerSecond float64 `mapstructure:"target_traces_per_second"`
+	MinSamplingRatio      float64 `mapstructure:"min_sampling_ratio"`
+	AdjustmentInterval    string  `mapstructure:"adjustment_interval"`
+}
+
+// AdaptiveSampler holds the throughput and the current ratio of each service of an adaptive rule
+type AdaptiveSampler struct {
+	rule        *AdaptiveRule
+	interval    time.Duration
+	now         func() time.Time
+	maxServices int
+
+	mu        sync.Mutex
+	services  map[string]*adaptiveServiceState
+	lastSweep time.Time
+}
+
+type adaptiveServiceState struct {
+	windowStart time.Time
+	windowCount int64
+	lastSeen    time.Time
+	// smoothed traces per second observed for the service, before sampling
+	throughput float64
+	ratio      float64
+}
+
+func (ar *AdaptiveRule) Validate() error {
+	if ar.TargetTracesPerSecond <= 0 {
+		return errors.New("target traces per second must be positive")
+	}
+	if ar.MinSamplingRatio == 0 {
+		ar.MinSamplingRatio = defaultMinSamplingRatio
+	}
+	if ar.MinSamplingRatio < 0 || ar.MinSamplingRatio > 100 {
+		return errors.New("min sampling ratio must be between 0 and 100")
+	}
+
+	_, err := ar.adjustmentInterval()
+	return err
+}
+
+func (ar *AdaptiveRule) adjustmentInterval() (time.Duration, error) {
+	if ar.AdjustmentInterval == "" {
+		return defaultAdjustmentInterval, nil
+	}
+	interval, err := time.ParseDuration(ar.AdjustmentInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid adjustment interval %q: %w", ar.AdjustmentInterval, err)
+	}
+	if interval <= 0 {
+		return 0, errors.New("adjustment interval must be positive")
+	}
+	return interval, nil
+}
+
+// NewAdaptiveSampler returns the sampler of a validated rule, it starts with no services
+func NewAdaptiveSampler(rule *AdaptiveRule) *AdaptiveSampler {
+	interval, err := rule.adjustmentInterval()
+	if err != nil {
+		interval = defaultAdjustmentInterval
+	}
+	return &AdaptiveSampler{
+		rule:        rule,
+		interval:    interval,
+		now:         time.Now,
+		maxServices: defaultMaxTrackedServices,
+		services:    make(map[string]*adaptiveServiceState),
+	}
+}
+
+// KeepTraceDecision records the trace in the throughput of its services and returns the current ratio.
+// filterMatch is true if the trace contains a service the rule applies to, conditionMatch is true if the
+// services are below their target and the trace should be kept, otherwise ratio should be used.
+func (as *AdaptiveSampler) KeepTraceDecision(td ptrace.Traces) (filterMatch bool, conditionMatch bool, ratio float64) {
+	services := as.rule.traceServices(td)
+	if len(services) == 0 {
+		return false, false, 0
+	}
+
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	now := as.now()
+	as.evictIdle(now)
+	for _, service := range services {
+		state := as.observe(service, now)
+		if state.ratio > ratio {
+			ratio = state.ratio
+		}
+	}
+	return true, ratio >= 100, ratio
+}
+
+// Ratios returns the current sampling ratio of each service the rule has seen
+func (as *AdaptiveSampler) Ratios() map[string]float64 {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+
+	ratios := make(map[string]float64, len(as.services))
+	for service, state := range as.services {
+		ratios[service] = state.ratio
+	}
+	return ratios
+}
+
+func (ar *AdaptiveRule) traceServices(td ptrace.Traces) []string {
+	var services []string
+	seen := make(map[string]struct{})
+
+	resources := td.ResourceSpans()
+	for r := 0; r < resources.Len(); r++ {
+		serviceAttr, found := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
+		if !found {
+			continue
+		}
+		service := serviceAttr.AsString()
+		if ar.ServiceName != "" && service != ar.ServiceName {
+			continue
+		}
+		if _, ok := seen[service]; ok {
+			continue
+		}
+		seen[service] = struct{}{}
+		services = append(services, service)
+	}
+	return services
+}
+
+// observe counts a trace for the service and adjusts its ratio once per interval. must be called with mu held.
+func (as *AdaptiveSampler) observe(service string, now time.Time) *adaptiveServiceState {
+	state, found := as.services[service]
+	if !found {
+		if len(as.services) >= as.maxServices {
+			as.evictLeastRecentlySeen()
+		}
+		// keep everything until there is enough data to adjust the ratio
+		state = &adaptiveServiceState{windowStart: now, ratio: 100}
+		as.services[service] = state
+	}
+	state.lastSeen = now
+
+	elapsed := now.Sub(state.windowStart)
+	if elapsed >= as.interval {
+		observed := float64(state.windowCount) / elapsed.Seconds()
+		if state.throughput == 0 {
+			state.throughput = observed
+		} else {
+			state.throughput = throughputSmoothing*state.throughput + (1-throughputSmoothing)*observed
+		}
+		state.ratio = as.ratioForThroughput(state.throughput)
+		state.windowStart = now
+		state.windowCount = 0
+	}
+
+	state.windowCount++
+	return state
+}
+
+// evictIdle forgets the services that had no traces for a while, at most once per interval. must be called with mu held.
+// a service that comes back starts over, keeping everything until its ratio is adjusted.
+func (as *AdaptiveSampler) evictIdle(now time.Time) {
+	if now.Sub(as.lastSweep) < as.interval {
+		return
+	}
+	as.lastSweep = now
+
+	idleTimeout := as.interval * idleIntervalsBeforeEviction
+	for service, state := range as.services {
+		if now.Sub(state.lastSeen) >= idleTimeout {
+			delete(as.services, service)
+		}
+	}
+}
+
+// evictLeastRecentlySeen makes room for a new service when the rule tracks too many. must be called with mu held.
+func (as *AdaptiveSampler) evictLeastRecentlySeen() {
+	var (
+		oldestService string
+		oldestSeen    time.Time
+	)
+	for service, state := range as.services {
+		if oldestService == "" || state.lastSeen.Before(oldestSeen) {
+			oldestService = service
+			oldestSeen = state.lastSeen
+		}
+	}
+	delete(as.services, oldestService)
+}
+
+func (as *AdaptiveSampler) ratioForThroughput(throughput float64) float64 {
+	if throughput <= as.rule.TargetTracesPerSecond {
+		return 100
+	}
+	ratio := as.rule.TargetTracesPerSecond / throughput * 100
+	if ratio < as.rule.MinSamplingRatio {
+		return as.rule.MinSamplingRatio
+	}
+	return ratio
+}
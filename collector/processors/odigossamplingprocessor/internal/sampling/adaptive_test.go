@@ -0,0 +1,105 @@
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveRuleAdjustsRatio(t *testing.T) {
+	now := time.Unix(0, 0)
+	rule := &AdaptiveRule{
+		TargetTracesPerSecond: 10,
+		AdjustmentInterval:    "10s",
+	}
+	require.NoError(t, rule.Validate())
+	sampler := NewAdaptiveSampler(rule)
+	sampler.now = func() time.Time { return now }
+
+	td, _ := newTraceWithSpan("frontend")
+
+	// everything is kept until the first adjustment
+	filterMatch, conditionMatch, ratio := sampler.KeepTraceDecision(td)
+	assert.True(t, filterMatch)
+	assert.True(t, conditionMatch)
+	assert.Equal(t, 100.0, ratio)
+
+	// 1000 traces in 10 seconds is 100 traces per second, 10 times the target
+	for i := 0; i < 999; i++ {
+		sampler.KeepTraceDecision(td)
+	}
+	now = now.Add(10 * time.Second)
+	filterMatch, conditionMatch, ratio = sampler.KeepTraceDecision(td)
+	assert.True(t, filterMatch)
+	assert.False(t, conditionMatch)
+	assert.InDelta(t, 10.0, ratio, 0.01)
+	assert.InDelta(t, 10.0, sampler.Ratios()["frontend"], 0.01)
+
+	// traffic drops below the target, the smoothed throughput brings the ratio back up
+	for i := 0; i < 9; i++ {
+		sampler.KeepTraceDecision(td)
+	}
+	now = now.Add(10 * time.Second)
+	_, _, ratio = sampler.KeepTraceDecision(td)
+	assert.InDelta(t, 10.0/50.5*100, ratio, 0.01)
+}
+
+func TestAdaptiveRuleServiceFilter(t *testing.T) {
+	rule := &AdaptiveRule{ServiceName: "checkout", TargetTracesPerSecond: 10}
+	require.NoError(t, rule.Validate())
+	sampler := NewAdaptiveSampler(rule)
+
+	td, _ := newTraceWithSpan("frontend")
+	filterMatch, _, _ := sampler.KeepTraceDecision(td)
+	assert.False(t, filterMatch)
+	assert.Empty(t, sampler.Ratios())
+}
+
+func TestAdaptiveRuleEvictsIdleServices(t *testing.T) {
+	now := time.Unix(0, 0)
+	rule := &AdaptiveRule{
+		TargetTracesPerSecond: 10,
+		AdjustmentInterval:    "10s",
+	}
+	require.NoError(t, rule.Validate())
+	sampler := NewAdaptiveSampler(rule)
+	sampler.now = func() time.Time { return now }
+
+	frontend, _ := newTraceWithSpan("frontend")
+	backend, _ := newTraceWithSpan("backend")
+	sampler.KeepTraceDecision(frontend)
+	sampler.KeepTraceDecision(backend)
+	assert.Len(t, sampler.Ratios(), 2)
+
+	// only frontend keeps sending traces, backend is forgotten once idle for long enough
+	for i := 0; i < idleIntervalsBeforeEviction; i++ {
+		now = now.Add(10 * time.Second)
+		sampler.KeepTraceDecision(frontend)
+	}
+	ratios := sampler.Ratios()
+	assert.Len(t, ratios, 1)
+	assert.Contains(t, ratios, "frontend")
+}
+
+func TestAdaptiveRuleCapsTrackedServices(t *testing.T) {
+	now := time.Unix(0, 0)
+	rule := &AdaptiveRule{
+		TargetTracesPerSecond: 10,
+	}
+	require.NoError(t, rule.Validate())
+	sampler := NewAdaptiveSampler(rule)
+	sampler.now = func() time.Time { return now }
+	sampler.maxServices = 2
+
+	for _, service := range []string{"a", "b", "c"} {
+		td, _ := newTraceWithSpan(service)
+		sampler.KeepTraceDecision(td)
+		now = now.Add(time.Second)
+	}
+
+	ratios := sampler.Ratios()
+	assert.Len(t, ratios, 2)
+	assert.NotContains(t, ratios, "a")
+}
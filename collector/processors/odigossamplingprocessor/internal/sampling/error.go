@@ -1,23 +1,80 @@
 package sampling
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+const (
+	exceptionEventName        = "exception"
+	exceptionTypeAttribute    = "exception.type"
+	httpStatusCodeAttribute   = "http.status_code"
+	httpResponseStatusCodeKey = "http.response.status_code"
 )
 
 type ErrorRule struct {
 	FallbackSamplingRatio float64 `mapstructure:"fallback_sampling_ratio"`
+	// when set, only spans of this service are considered
+	ServiceName string `mapstructure:"service_name"`
+	// http status codes considered as errors, e.g. "5xx", "500-504" or "429"
+	HttpStatusCodes []string `mapstructure:"http_status_codes"`
+	// http status codes never considered as errors, e.g. "404"
+	ExcludedHttpStatusCodes []string `mapstructure:"excluded_http_status_codes"`
+	// spans with an exception event are considered as errors
+	ExceptionEvents bool `mapstructure:"exception_events"`
+	// only exception events with one of these exception.type values are considered as errors
+	ExceptionTypes []string `mapstructure:"exception_types"`
+
+	statusCodes         []statusCodeRange
+	excludedStatusCodes []statusCodeRange
+}
+
+type statusCodeRange struct {
+	min int64
+	max int64
+}
+
+func (scr statusCodeRange) contains(code int64) bool {
+	return code >= scr.min && code <= scr.max
 }
 
 func (tlr *ErrorRule) Validate() error {
+	if tlr.FallbackSamplingRatio < 0 || tlr.FallbackSamplingRatio > 100 {
+		return errors.New("fallback sampling ratio must be between 0 and 100")
+	}
+
+	var err error
+	if tlr.statusCodes, err = parseStatusCodeRanges(tlr.HttpStatusCodes); err != nil {
+		return err
+	}
+	if tlr.excludedStatusCodes, err = parseStatusCodeRanges(tlr.ExcludedHttpStatusCodes); err != nil {
+		return err
+	}
 	return nil
 }
 
-func (tlr *ErrorRule) KeepTraceDecision(td ptrace.Traces) (conditionMatch bool) {
+// KeepTraceDecision reports whether the trace contains a span of the configured service (filterMatch,
+// always true when the rule is not scoped to a service) and whether one of those spans is an error (conditionMatch).
+func (tlr *ErrorRule) KeepTraceDecision(td ptrace.Traces) (filterMatch bool, conditionMatch bool) {
 
 	resources := td.ResourceSpans()
+	filterMatch = tlr.ServiceName == ""
 
 	// Iterate over resources
 	for r := 0; r < resources.Len(); r++ {
+		if tlr.ServiceName != "" {
+			serviceName, _ := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
+			if serviceName.AsString() != tlr.ServiceName {
+				continue
+			}
+			filterMatch = true
+		}
+
 		scoreSpan := resources.At(r).ScopeSpans()
 
 		// Iterate over scopes
@@ -28,12 +85,129 @@ func (tlr *ErrorRule) KeepTraceDecision(td ptrace.Traces) (conditionMatch bool)
 			for k := 0; k < ils.Spans().Len(); k++ {
 				span := ils.Spans().At(k)
 
-				statusCode := span.Status().Code().String()
-				if statusCode == ptrace.StatusCodeError.String() {
-					return true
+				if tlr.isErrorSpan(span) {
+					return true, true
 				}
 			}
 		}
 	}
+	return filterMatch, false
+}
+
+func (tlr *ErrorRule) isErrorSpan(span ptrace.Span) bool {
+	if (tlr.ExceptionEvents || len(tlr.ExceptionTypes) > 0) && tlr.hasException(span) {
+		return true
+	}
+
+	statusCode, hasStatusCode := httpStatusCode(span)
+	if hasStatusCode {
+		if matchStatusCode(tlr.excludedStatusCodes, statusCode) {
+			return false
+		}
+		if len(tlr.statusCodes) > 0 {
+			return matchStatusCode(tlr.statusCodes, statusCode)
+		}
+	}
+
+	return span.Status().Code() == ptrace.StatusCodeError
+}
+
+func (tlr *ErrorRule) hasException(span ptrace.Span) bool {
+	events := span.Events()
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		if event.Name() != exceptionEventName {
+			continue
+		}
+		if len(tlr.ExceptionTypes) == 0 {
+			return true
+		}
+		exceptionType, found := event.Attributes().Get(exceptionTypeAttribute)
+		if !found {
+			continue
+		}
+		for _, t := range tlr.ExceptionTypes {
+			if exceptionType.AsString() == t {
+				return true
+			}
+		}
+	}
 	return false
 }
+
+// httpStatusCode returns the http status code of the span, supporting both the old and the new semantic conventions
+func httpStatusCode(span ptrace.Span) (int64, bool) {
+	for _, key := range []string{httpResponseStatusCodeKey, httpStatusCodeAttribute} {
+		value, found := span.Attributes().Get(key)
+		if !found {
+			continue
+		}
+		code, err := strconv.ParseInt(value.AsString(), 10, 64)
+		if err == nil {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+func matchStatusCode(ranges []statusCodeRange, code int64) bool {
+	for _, scr := range ranges {
+		if scr.contains(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseStatusCodeRanges parses status codes in the formats "404", "5xx" or "500-504"
+func parseStatusCodeRanges(codes []string) ([]statusCodeRange, error) {
+	ranges := make([]statusCodeRange, 0, len(codes))
+	for _, code := range codes {
+		scr, err := parseStatusCodeRange(strings.TrimSpace(code))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, scr)
+	}
+	return ranges, nil
+}
+
+func parseStatusCodeRange(code string) (statusCodeRange, error) {
+	lower := strings.ToLower(code)
+	if len(lower) == 3 && strings.HasSuffix(lower, "xx") {
+		class, err := strconv.ParseInt(lower[:1], 10, 64)
+		if err != nil || class < 1 || class > 5 {
+			return statusCodeRange{}, fmt.Errorf("invalid http status code class: %s", code)
+		}
+		return statusCodeRange{min: class * 100, max: class*100 + 99}, nil
+	}
+
+	if from, to, found := strings.Cut(code, "-"); found {
+		low, err := parseStatusCode(from)
+		if err != nil {
+			return statusCodeRange{}, err
+		}
+		high, err := parseStatusCode(to)
+		if err != nil {
+			return statusCodeRange{}, err
+		}
+		if low > high {
+			return statusCodeRange{}, fmt.Errorf("invalid http status code range: %s", code)
+		}
+		return statusCodeRange{min: low, max: high}, nil
+	}
+
+	single, err := parseStatusCode(code)
+	if err != nil {
+		return statusCodeRange{}, err
+	}
+	return statusCodeRange{min: single, max: single}, nil
+}
+
+func parseStatusCode(code string) (int64, error) {
+	value, err := strconv.ParseInt(strings.TrimSpace(code), 10, 64)
+	if err != nil || value < 100 || value > 599 {
+		return 0, fmt.Errorf("invalid http status code: %s", code)
+	}
+	return value, nil
+}
@@ -0,0 +1,103 @@
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTraceWithSpan(serviceName string) (ptrace.Traces, ptrace.Span) {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", serviceName)
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	return td, span
+}
+
+func TestParseStatusCodeRange(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    statusCodeRange
+		wantErr bool
+	}{
+		{code: "5xx", want: statusCodeRange{min: 500, max: 599}},
+		{code: "4XX", want: statusCodeRange{min: 400, max: 499}},
+		{code: "500-504", want: statusCodeRange{min: 500, max: 504}},
+		{code: "404", want: statusCodeRange{min: 404, max: 404}},
+		{code: "9xx", wantErr: true},
+		{code: "504-500", wantErr: true},
+		{code: "abc", wantErr: true},
+		{code: "1000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := parseStatusCodeRange(tt.code)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestErrorRuleKeepTraceDecision(t *testing.T) {
+	rule := &ErrorRule{
+		HttpStatusCodes:         []string{"5xx", "429"},
+		ExcludedHttpStatusCodes: []string{"503"},
+		ExceptionTypes:          []string{"java.lang.NullPointerException"},
+	}
+	require.NoError(t, rule.Validate())
+
+	td, span := newTraceWithSpan("frontend")
+	span.Attributes().PutInt("http.response.status_code", 500)
+	assertErrorDecision(t, rule, td, true, true)
+
+	// 4xx span marked with error status is not an error unless configured
+	td, span = newTraceWithSpan("frontend")
+	span.Attributes().PutInt("http.status_code", 404)
+	span.Status().SetCode(ptrace.StatusCodeError)
+	assertErrorDecision(t, rule, td, true, false)
+
+	td, span = newTraceWithSpan("frontend")
+	span.Attributes().PutInt("http.status_code", 503)
+	assertErrorDecision(t, rule, td, true, false)
+
+	td, span = newTraceWithSpan("frontend")
+	event := span.Events().AppendEmpty()
+	event.SetName("exception")
+	event.Attributes().PutStr("exception.type", "java.lang.NullPointerException")
+	assertErrorDecision(t, rule, td, true, true)
+
+	// non http spans still rely on the span status
+	td, span = newTraceWithSpan("frontend")
+	span.Status().SetCode(ptrace.StatusCodeError)
+	assertErrorDecision(t, rule, td, true, true)
+}
+
+func TestErrorRuleServiceName(t *testing.T) {
+	rule := &ErrorRule{ServiceName: "checkout"}
+	require.NoError(t, rule.Validate())
+
+	td, span := newTraceWithSpan("frontend")
+	span.Status().SetCode(ptrace.StatusCodeError)
+	assertErrorDecision(t, rule, td, false, false)
+
+	td, span = newTraceWithSpan("checkout")
+	span.Status().SetCode(ptrace.StatusCodeError)
+	assertErrorDecision(t, rule, td, true, true)
+
+	td, _ = newTraceWithSpan("checkout")
+	assertErrorDecision(t, rule, td, true, false)
+}
+
+func assertErrorDecision(t *testing.T, rule *ErrorRule, td ptrace.Traces, wantFilter, wantCondition bool) {
+	t.Helper()
+	filterMatch, conditionMatch := rule.KeepTraceDecision(td)
+	assert.Equal(t, wantFilter, filterMatch)
+	assert.Equal(t, wantCondition, conditionMatch)
+}
@@ -2,21 +2,49 @@ package sampling
 
 import (
 	"errors"
+	"fmt"
+	"path"
+	"regexp"
 	"strings"
+	"time"
 
-	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 )
 
+// Span attributes the latency rule route can be matched against
+const (
+	MatchKeyHttpRoute            = "http.route"
+	MatchKeyRpcMethod            = "rpc.method"
+	MatchKeyMessagingDestination = "messaging.destination.name"
+)
+
+// How the latency rule route is matched against the span attribute
+const (
+	MatchTypePrefix = "prefix"
+	MatchTypeGlob   = "glob"
+	MatchTypeRegex  = "regex"
+)
+
 type HttpRouteLatencyRule struct {
 	HttpRoute             string  `mapstructure:"http_route"`
+	MatchKey              string  `mapstructure:"match_key"`
+	MatchType             string  `mapstructure:"match_type"`
 	Threshold             int     `mapstructure:"threshold"`
 	ServiceName           string  `mapstructure:"service_name"`
 	FallbackSamplingRatio float64 `mapstructure:"fallback_sampling_ratio"`
+
+	routeRegex *regexp.Regexp
 }
 
 func (tlr *HttpRouteLatencyRule) Validate() error {
+	if tlr.MatchKey == "" {
+		tlr.MatchKey = MatchKeyHttpRoute
+	}
+	if tlr.MatchType == "" {
+		tlr.MatchType = MatchTypePrefix
+	}
+
 	switch {
 	case tlr.Threshold <= 0:
 		return errors.New("threshold must be a positive integer")
@@ -24,36 +52,48 @@ func (tlr *HttpRouteLatencyRule) Validate() error {
 		return errors.New("service cannot be empty")
 	case tlr.HttpRoute == "":
 		return errors.New("endpoint cannot be empty")
-	case !strings.HasPrefix(tlr.HttpRoute, "/"):
-		return errors.New("endpoint must start with '/'")
+	case tlr.MatchKey != MatchKeyHttpRoute && tlr.MatchKey != MatchKeyRpcMethod && tlr.MatchKey != MatchKeyMessagingDestination:
+		return fmt.Errorf("unsupported match key: %s", tlr.MatchKey)
+	}
+
+	switch tlr.MatchType {
+	case MatchTypePrefix:
+		if tlr.MatchKey == MatchKeyHttpRoute && !strings.HasPrefix(tlr.HttpRoute, "/") {
+			return errors.New("endpoint must start with '/'")
+		}
+	case MatchTypeGlob:
+		if _, err := path.Match(tlr.HttpRoute, ""); err != nil {
+			return fmt.Errorf("invalid glob endpoint %q: %w", tlr.HttpRoute, err)
+		}
+	case MatchTypeRegex:
+		re, err := regexp.Compile(tlr.HttpRoute)
+		if err != nil {
+			return fmt.Errorf("invalid regex endpoint %q: %w", tlr.HttpRoute, err)
+		}
+		tlr.routeRegex = re
+	default:
+		return fmt.Errorf("unsupported match type: %s", tlr.MatchType)
 	}
 	return nil
 }
 
+// KeepTraceDecision looks for spans of the configured service whose match key attribute matches the route.
+// filterMatch is true if such spans exist, and conditionMatch is true if any of them lasted longer than the threshold.
 func (tlr *HttpRouteLatencyRule) KeepTraceDecision(td ptrace.Traces) (filterMatch bool, conditionMatch bool) {
 	var (
-		serviceFound  = false
-		endpointFound = false
+		endpointFound  = false
+		maxSpanLatency time.Duration
 	)
 
 	resources := td.ResourceSpans()
 
-	// Check if the service matches
+	// Iterate over resources
 	for r := 0; r < resources.Len(); r++ {
-		serviceAttr, _ := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
-		if serviceAttr.AsString() == tlr.ServiceName {
-			serviceFound = true
+		serviceName, _ := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
+		if serviceName.AsString() != tlr.ServiceName {
+			continue
 		}
-	}
-	if !serviceFound {
-		return false, true
-	}
-
-	var minStart pcommon.Timestamp
-	var maxEnd pcommon.Timestamp
 
-	// Iterate over resources
-	for r := 0; r < resources.Len(); r++ {
 		scoreSpan := resources.At(r).ScopeSpans()
 
 		// Iterate over scopes
@@ -64,32 +104,57 @@ func (tlr *HttpRouteLatencyRule) KeepTraceDecision(td ptrace.Traces) (filterMatc
 			for k := 0; k < ils.Spans().Len(); k++ {
 				span := ils.Spans().At(k)
 
-				endpoint, found := span.Attributes().Get("http.route")
-				if found {
-					serviceName, _ := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
-					isEndpointFoundOnService := serviceName.AsString() == tlr.ServiceName
-
-					if tlr.matchEndpoint(endpoint.AsString(), tlr.HttpRoute) && isEndpointFoundOnService {
-						endpointFound = true
-					}
+				endpoint, found := span.Attributes().Get(tlr.MatchKey)
+				if !found || !tlr.matchEndpoint(endpoint.AsString()) {
+					continue
 				}
 
-				start := span.StartTimestamp()
-				end := span.EndTimestamp()
-
-				if minStart == 0 || start < minStart {
-					minStart = start
-				}
-				if maxEnd == 0 || end > maxEnd {
-					maxEnd = end
+				endpointFound = true
+				latency := span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime())
+				if latency > maxSpanLatency {
+					maxSpanLatency = latency
 				}
 			}
 		}
 	}
-	duration := maxEnd.AsTime().Sub(minStart.AsTime())
-	return endpointFound, duration.Milliseconds() > int64(tlr.Threshold)
+
+	if !endpointFound {
+		return false, true
+	}
+	return true, maxSpanLatency.Milliseconds() > int64(tlr.Threshold)
 }
 
-func (tlr *HttpRouteLatencyRule) matchEndpoint(rootSpanEndpoint string, samplerEndpoint string) bool {
-	return strings.HasPrefix(rootSpanEndpoint, samplerEndpoint)
+func (tlr *HttpRouteLatencyRule) matchEndpoint(spanEndpoint string) bool {
+	switch tlr.MatchType {
+	case MatchTypeGlob:
+		return matchGlob(strings.Split(tlr.HttpRoute, "/"), strings.Split(spanEndpoint, "/"))
+	case MatchTypeRegex:
+		return tlr.routeRegex != nil && tlr.routeRegex.MatchString(spanEndpoint)
+	default:
+		return strings.HasPrefix(spanEndpoint, tlr.HttpRoute)
+	}
+}
+
+// matchGlob matches the segments of the endpoint against the segments of the glob pattern.
+// each segment is matched with path.Match, so `*` does not cross a `/`,
+// and a `**` segment matches any number of segments, including none.
+func matchGlob(pattern []string, segments []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchGlob(pattern[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+			return false
+		}
+		pattern, segments = pattern[1:], segments[1:]
+	}
+	return len(segments) == 0
 }
@@ -0,0 +1,229 @@
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func setSpanLatency(span ptrace.Span, latency time.Duration) {
+	start := time.Unix(0, 0)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(latency)))
+}
+
+func TestHttpRouteLatencyRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    HttpRouteLatencyRule
+		wantErr bool
+	}{
+		{name: "defaults to http route prefix", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100}},
+		{name: "glob", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/*/items", MatchType: MatchTypeGlob, Threshold: 100}},
+		{name: "regex", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: `^/api/v\d+/`, MatchType: MatchTypeRegex, Threshold: 100}},
+		{name: "rpc method prefix", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "Get", MatchKey: MatchKeyRpcMethod, Threshold: 100}},
+		{name: "messaging destination", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "orders", MatchKey: MatchKeyMessagingDestination, Threshold: 100}},
+		{name: "http route prefix without slash", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "api", Threshold: 100}, wantErr: true},
+		{name: "invalid glob", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/[", MatchType: MatchTypeGlob, Threshold: 100}, wantErr: true},
+		{name: "invalid regex", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "(", MatchType: MatchTypeRegex, Threshold: 100}, wantErr: true},
+		{name: "unknown match type", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", MatchType: "exact", Threshold: 100}, wantErr: true},
+		{name: "unknown match key", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", MatchKey: "url.path", Threshold: 100}, wantErr: true},
+		{name: "no threshold", rule: HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api"}, wantErr: true},
+		{name: "no service", rule: HttpRouteLatencyRule{HttpRoute: "/api", Threshold: 100}, wantErr: true},
+		{name: "no route", rule: HttpRouteLatencyRule{ServiceName: "frontend", Threshold: 100}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHttpRouteLatencyRuleKeepTraceDecision(t *testing.T) {
+	tests := []struct {
+		name               string
+		rule               HttpRouteLatencyRule
+		service            string
+		attrKey            string
+		attrValue          string
+		latency            time.Duration
+		wantFilterMatch    bool
+		wantConditionMatch bool
+	}{
+		{
+			name:            "prefix below threshold",
+			rule:            HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100},
+			service:         "frontend",
+			attrKey:         MatchKeyHttpRoute,
+			attrValue:       "/api/items",
+			latency:         50 * time.Millisecond,
+			wantFilterMatch: true,
+		},
+		{
+			name:               "prefix above threshold",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/items",
+			latency:            150 * time.Millisecond,
+			wantFilterMatch:    true,
+			wantConditionMatch: true,
+		},
+		{
+			name:               "prefix other route",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/health",
+			latency:            150 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+		{
+			name:               "other service",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100},
+			service:            "backend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/items",
+			latency:            50 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+		{
+			name:            "glob",
+			rule:            HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/*/items", MatchType: MatchTypeGlob, Threshold: 100},
+			service:         "frontend",
+			attrKey:         MatchKeyHttpRoute,
+			attrValue:       "/api/v1/items",
+			latency:         50 * time.Millisecond,
+			wantFilterMatch: true,
+		},
+		{
+			name:               "glob does not cross segments",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/*/items", MatchType: MatchTypeGlob, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/v1/v2/items",
+			latency:            50 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+		{
+			name:            "glob double star crosses segments",
+			rule:            HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/**/items", MatchType: MatchTypeGlob, Threshold: 100},
+			service:         "frontend",
+			attrKey:         MatchKeyHttpRoute,
+			attrValue:       "/api/v1/v2/items",
+			latency:         50 * time.Millisecond,
+			wantFilterMatch: true,
+		},
+		{
+			name:            "glob double star matches no segment",
+			rule:            HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/**/items", MatchType: MatchTypeGlob, Threshold: 100},
+			service:         "frontend",
+			attrKey:         MatchKeyHttpRoute,
+			attrValue:       "/api/items",
+			latency:         50 * time.Millisecond,
+			wantFilterMatch: true,
+		},
+		{
+			name:               "glob trailing double star",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/**", MatchType: MatchTypeGlob, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/v1/items/42",
+			latency:            150 * time.Millisecond,
+			wantFilterMatch:    true,
+			wantConditionMatch: true,
+		},
+		{
+			name:               "glob double star other prefix",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api/**", MatchType: MatchTypeGlob, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/health",
+			latency:            50 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+		{
+			name:               "regex",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: `^/api/v\d+/`, MatchType: MatchTypeRegex, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/v2/items",
+			latency:            150 * time.Millisecond,
+			wantFilterMatch:    true,
+			wantConditionMatch: true,
+		},
+		{
+			name:               "regex no match",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: `^/api/v\d+/`, MatchType: MatchTypeRegex, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "/api/latest/items",
+			latency:            50 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+		{
+			name:            "rpc method",
+			rule:            HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "Get", MatchKey: MatchKeyRpcMethod, Threshold: 100},
+			service:         "frontend",
+			attrKey:         MatchKeyRpcMethod,
+			attrValue:       "GetItems",
+			latency:         50 * time.Millisecond,
+			wantFilterMatch: true,
+		},
+		{
+			name:               "match key attribute missing",
+			rule:               HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "orders", MatchKey: MatchKeyMessagingDestination, Threshold: 100},
+			service:            "frontend",
+			attrKey:            MatchKeyHttpRoute,
+			attrValue:          "orders",
+			latency:            50 * time.Millisecond,
+			wantConditionMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.rule.Validate())
+
+			td, span := newTraceWithSpan(tt.service)
+			span.Attributes().PutStr(tt.attrKey, tt.attrValue)
+			setSpanLatency(span, tt.latency)
+
+			filterMatch, conditionMatch := tt.rule.KeepTraceDecision(td)
+			assert.Equal(t, tt.wantFilterMatch, filterMatch)
+			assert.Equal(t, tt.wantConditionMatch, conditionMatch)
+		})
+	}
+}
+
+func TestHttpRouteLatencyRuleUsesLongestMatchingSpan(t *testing.T) {
+	rule := HttpRouteLatencyRule{ServiceName: "frontend", HttpRoute: "/api", Threshold: 100}
+	require.NoError(t, rule.Validate())
+
+	td, span := newTraceWithSpan("frontend")
+	span.Attributes().PutStr(MatchKeyHttpRoute, "/api/items")
+	setSpanLatency(span, 50*time.Millisecond)
+
+	slow := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty()
+	slow.Attributes().PutStr(MatchKeyHttpRoute, "/api/orders")
+	setSpanLatency(slow, 200*time.Millisecond)
+
+	// a slow span of another route does not count
+	other := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty()
+	other.Attributes().PutStr(MatchKeyHttpRoute, "/health")
+	setSpanLatency(other, time.Second)
+
+	filterMatch, conditionMatch := rule.KeepTraceDecision(td)
+	assert.True(t, filterMatch)
+	assert.True(t, conditionMatch)
+}
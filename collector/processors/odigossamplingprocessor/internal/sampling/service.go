@@ -0,0 +1,37 @@
+package sampling
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+type ServiceNameRule struct {
+	ServiceName   string  `mapstructure:"service_name"`
+	SamplingRatio float64 `mapstructure:"sampling_ratio"`
+}
+
+func (snr *ServiceNameRule) Validate() error {
+	switch {
+	case snr.ServiceName == "":
+		return errors.New("service cannot be empty")
+	case snr.SamplingRatio < 0 || snr.SamplingRatio > 100:
+		return errors.New("sampling ratio must be between 0 and 100")
+	}
+	return nil
+}
+
+// KeepTraceDecision reports whether the trace contains a span of the configured service (filterMatch)
+// and whether the rule keeps all of that service's traces (conditionMatch).
+func (snr *ServiceNameRule) KeepTraceDecision(td ptrace.Traces) (filterMatch bool, conditionMatch bool) {
+	resources := td.ResourceSpans()
+
+	for r := 0; r < resources.Len(); r++ {
+		serviceAttr, found := resources.At(r).Resource().Attributes().Get(string(semconv.ServiceNameKey))
+		if found && serviceAttr.AsString() == snr.ServiceName {
+			return true, snr.SamplingRatio >= 100
+		}
+	}
+	return false, false
+}
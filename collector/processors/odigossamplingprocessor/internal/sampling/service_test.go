@@ -0,0 +1,68 @@
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceNameRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    ServiceNameRule
+		wantErr bool
+	}{
+		{name: "keep all", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 100}},
+		{name: "drop all", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 0}},
+		{name: "partial", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 12.5}},
+		{name: "empty service", rule: ServiceNameRule{SamplingRatio: 50}, wantErr: true},
+		{name: "negative ratio", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: -1}, wantErr: true},
+		{name: "ratio above 100", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 101}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceNameRuleKeepTraceDecision(t *testing.T) {
+	tests := []struct {
+		name               string
+		rule               ServiceNameRule
+		service            string
+		wantFilterMatch    bool
+		wantConditionMatch bool
+	}{
+		{name: "other service", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 100}, service: "backend"},
+		{name: "keep all", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 100}, service: "frontend", wantFilterMatch: true, wantConditionMatch: true},
+		{name: "partial", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 30}, service: "frontend", wantFilterMatch: true},
+		{name: "drop all", rule: ServiceNameRule{ServiceName: "frontend", SamplingRatio: 0}, service: "frontend", wantFilterMatch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td, _ := newTraceWithSpan(tt.service)
+			filterMatch, conditionMatch := tt.rule.KeepTraceDecision(td)
+			assert.Equal(t, tt.wantFilterMatch, filterMatch)
+			assert.Equal(t, tt.wantConditionMatch, conditionMatch)
+		})
+	}
+}
+
+func TestServiceNameRuleMatchesAnyResource(t *testing.T) {
+	rule := ServiceNameRule{ServiceName: "backend", SamplingRatio: 100}
+
+	td, _ := newTraceWithSpan("frontend")
+	td.ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("service.name", "backend")
+
+	filterMatch, conditionMatch := rule.KeepTraceDecision(td)
+	assert.True(t, filterMatch)
+	assert.True(t, conditionMatch)
+}
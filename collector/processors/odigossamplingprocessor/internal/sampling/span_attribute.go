@@ -0,0 +1,89 @@
+package sampling
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	AttributeConditionExists = "exists"
+	AttributeConditionEquals = "equals"
+	AttributeConditionRegex  = "regex"
+)
+
+type SpanAttributeRule struct {
+	AttributeKey          string  `mapstructure:"attribute_key"`
+	Condition             string  `mapstructure:"condition"`
+	Value                 string  `mapstructure:"value"`
+	FallbackSamplingRatio float64 `mapstructure:"fallback_sampling_ratio"`
+
+	valueRegex *regexp.Regexp
+}
+
+func (sar *SpanAttributeRule) Validate() error {
+	if sar.AttributeKey == "" {
+		return errors.New("attribute key cannot be empty")
+	}
+	if sar.FallbackSamplingRatio < 0 || sar.FallbackSamplingRatio > 100 {
+		return errors.New("fallback sampling ratio must be between 0 and 100")
+	}
+
+	switch sar.Condition {
+	case AttributeConditionExists:
+	case AttributeConditionEquals:
+		if sar.Value == "" {
+			return errors.New("value cannot be empty for the equals condition")
+		}
+	case AttributeConditionRegex:
+		re, err := regexp.Compile(sar.Value)
+		if err != nil {
+			return fmt.Errorf("invalid regex value %q: %w", sar.Value, err)
+		}
+		sar.valueRegex = re
+	default:
+		return fmt.Errorf("unknown attribute condition: %s", sar.Condition)
+	}
+	return nil
+}
+
+// KeepTraceDecision returns true if any span of the trace has an attribute satisfying the rule condition
+func (sar *SpanAttributeRule) KeepTraceDecision(td ptrace.Traces) (conditionMatch bool) {
+	resources := td.ResourceSpans()
+
+	// Iterate over resources
+	for r := 0; r < resources.Len(); r++ {
+		scopeSpans := resources.At(r).ScopeSpans()
+
+		// Iterate over scopes
+		for j := 0; j < scopeSpans.Len(); j++ {
+			ils := scopeSpans.At(j)
+
+			// iterate over spans
+			for k := 0; k < ils.Spans().Len(); k++ {
+				span := ils.Spans().At(k)
+
+				value, found := span.Attributes().Get(sar.AttributeKey)
+				if found && sar.matchValue(value) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (sar *SpanAttributeRule) matchValue(value pcommon.Value) bool {
+	switch sar.Condition {
+	case AttributeConditionExists:
+		return true
+	case AttributeConditionEquals:
+		return value.AsString() == sar.Value
+	case AttributeConditionRegex:
+		return sar.valueRegex != nil && sar.valueRegex.MatchString(value.AsString())
+	}
+	return false
+}
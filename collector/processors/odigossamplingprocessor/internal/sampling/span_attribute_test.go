@@ -0,0 +1,110 @@
+package sampling
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanAttributeRuleValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    SpanAttributeRule
+		wantErr bool
+	}{
+		{name: "exists", rule: SpanAttributeRule{AttributeKey: "user.id", Condition: AttributeConditionExists}},
+		{name: "equals", rule: SpanAttributeRule{AttributeKey: "user.tier", Condition: AttributeConditionEquals, Value: "gold"}},
+		{name: "regex", rule: SpanAttributeRule{AttributeKey: "user.email", Condition: AttributeConditionRegex, Value: `@example\.com$`}},
+		{name: "empty key", rule: SpanAttributeRule{Condition: AttributeConditionExists}, wantErr: true},
+		{name: "equals without value", rule: SpanAttributeRule{AttributeKey: "user.tier", Condition: AttributeConditionEquals}, wantErr: true},
+		{name: "invalid regex", rule: SpanAttributeRule{AttributeKey: "user.email", Condition: AttributeConditionRegex, Value: "("}, wantErr: true},
+		{name: "unknown condition", rule: SpanAttributeRule{AttributeKey: "user.id", Condition: "contains"}, wantErr: true},
+		{name: "ratio above 100", rule: SpanAttributeRule{AttributeKey: "user.id", Condition: AttributeConditionExists, FallbackSamplingRatio: 101}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpanAttributeRuleKeepTraceDecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      SpanAttributeRule
+		setAttr   func(attrs map[string]any)
+		wantMatch bool
+	}{
+		{
+			name:      "exists",
+			rule:      SpanAttributeRule{AttributeKey: "user.id", Condition: AttributeConditionExists},
+			setAttr:   func(attrs map[string]any) { attrs["user.id"] = "42" },
+			wantMatch: true,
+		},
+		{
+			name:    "exists missing attribute",
+			rule:    SpanAttributeRule{AttributeKey: "user.id", Condition: AttributeConditionExists},
+			setAttr: func(attrs map[string]any) { attrs["user.name"] = "bob" },
+		},
+		{
+			name:      "equals",
+			rule:      SpanAttributeRule{AttributeKey: "user.tier", Condition: AttributeConditionEquals, Value: "gold"},
+			setAttr:   func(attrs map[string]any) { attrs["user.tier"] = "gold" },
+			wantMatch: true,
+		},
+		{
+			name:    "equals other value",
+			rule:    SpanAttributeRule{AttributeKey: "user.tier", Condition: AttributeConditionEquals, Value: "gold"},
+			setAttr: func(attrs map[string]any) { attrs["user.tier"] = "silver" },
+		},
+		{
+			name:      "equals non string value",
+			rule:      SpanAttributeRule{AttributeKey: "http.response.status_code", Condition: AttributeConditionEquals, Value: "418"},
+			setAttr:   func(attrs map[string]any) { attrs["http.response.status_code"] = int64(418) },
+			wantMatch: true,
+		},
+		{
+			name:      "regex",
+			rule:      SpanAttributeRule{AttributeKey: "user.email", Condition: AttributeConditionRegex, Value: `@example\.com$`},
+			setAttr:   func(attrs map[string]any) { attrs["user.email"] = "bob@example.com" },
+			wantMatch: true,
+		},
+		{
+			name:    "regex no match",
+			rule:    SpanAttributeRule{AttributeKey: "user.email", Condition: AttributeConditionRegex, Value: `@example\.com$`},
+			setAttr: func(attrs map[string]any) { attrs["user.email"] = "bob@example.org" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.rule.Validate())
+
+			attrs := map[string]any{}
+			tt.setAttr(attrs)
+			td, span := newTraceWithSpan("frontend")
+			require.NoError(t, span.Attributes().FromRaw(attrs))
+
+			assert.Equal(t, tt.wantMatch, tt.rule.KeepTraceDecision(td))
+		})
+	}
+}
+
+func TestSpanAttributeRuleMatchesAnySpan(t *testing.T) {
+	rule := SpanAttributeRule{AttributeKey: "user.tier", Condition: AttributeConditionEquals, Value: "gold"}
+	require.NoError(t, rule.Validate())
+
+	td, span := newTraceWithSpan("frontend")
+	span.Attributes().PutStr("user.tier", "silver")
+	second := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty()
+	second.Attributes().PutStr("user.tier", "gold")
+
+	assert.True(t, rule.KeepTraceDecision(td))
+}
@@ -0,0 +1,46 @@
+package sampling
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// SamplingProbabilityAttribute is set on spans of traces that were kept by a sampling ratio,
+// so backends can re-weight counts (a value of 0.2 means each kept trace represents 5 traces).
+const SamplingProbabilityAttribute = "sampling.probability"
+
+const (
+	// the hash space is split into buckets, same as the upstream probabilistic sampler,
+	// so that the same trace ids are selected by both for a given percentage.
+	numHashBuckets        = 0x4000
+	bitMaskHashBuckets    = numHashBuckets - 1
+	percentageScaleFactor = numHashBuckets / 100.0
+	hashSeed              = uint32(0)
+)
+
+// IsTraceIDSampled returns a decision derived from the trace id alone,
+// so different collector replicas and different batches of the same trace always agree.
+// ratio is a percentage between 0 and 100.
+func IsTraceIDSampled(traceID pcommon.TraceID, ratio float64) bool {
+	if ratio <= 0 {
+		return false
+	}
+	if ratio >= 100 {
+		return true
+	}
+	scaledSamplingRate := uint32(ratio * percentageScaleFactor)
+	return computeHash(traceID[:], hashSeed)&bitMaskHashBuckets < scaledSamplingRate
+}
+
+func computeHash(b []byte, seed uint32) uint32 {
+	seedBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(seedBytes, seed)
+
+	hash := fnv.New32a()
+	// fnv hash Write never returns an error
+	_, _ = hash.Write(seedBytes)
+	_, _ = hash.Write(b)
+	return hash.Sum32()
+}
@@ -0,0 +1,38 @@
+package sampling
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func traceIDFromInt(i uint64) pcommon.TraceID {
+	var tid [16]byte
+	binary.BigEndian.PutUint64(tid[8:], i)
+	return pcommon.TraceID(tid)
+}
+
+func TestIsTraceIDSampledIsDeterministic(t *testing.T) {
+	for i := uint64(0); i < 1000; i++ {
+		tid := traceIDFromInt(i)
+		assert.Equal(t, IsTraceIDSampled(tid, 30), IsTraceIDSampled(tid, 30))
+	}
+}
+
+func TestIsTraceIDSampledRatio(t *testing.T) {
+	const total = 100000
+	sampled := 0
+	for i := uint64(0); i < total; i++ {
+		tid := traceIDFromInt(i)
+		assert.True(t, IsTraceIDSampled(tid, 100))
+		assert.False(t, IsTraceIDSampled(tid, 0))
+		if IsTraceIDSampled(tid, 20) {
+			sampled++
+			// a trace sampled at a lower ratio is also sampled at any higher ratio
+			assert.True(t, IsTraceIDSampled(tid, 50))
+		}
+	}
+	assert.InDelta(t, 0.2, float64(sampled)/total, 0.01)
+}
@@ -2,49 +2,317 @@ package odigossamplingprocessor
 
 import (
 	"context"
-	"math/rand"
+	"sync"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossamplingprocessor/internal/sampling"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/zap"
 )
 
+// maxReleaseInterval is how often, at most, the buffer is checked for traces whose decision wait is over
+const maxReleaseInterval = time.Second
+
+// samplingProcessor holds the spans of each trace in memory for the decision wait,
+// so the rules are evaluated once per trace, on all of its spans.
 type samplingProcessor struct {
-	logger *zap.Logger
-	config *Config
+	logger       *zap.Logger
+	config       *Config
+	telemetry    *samplingTelemetry
+	nextConsumer consumer.Traces
+
+	// adaptive holds the throughput tracked for each adaptive rule
+	adaptive map[*Rule]*sampling.AdaptiveSampler
+
+	// mu guards the buffer and the decisions cache
+	mu        sync.Mutex
+	buffer    *traceBuffer
+	decisions *decisionCache
+
+	now      func() time.Time
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// samplingDecision is the outcome of the rules for a trace, reused for spans arriving after the trace was evaluated
+type samplingDecision struct {
+	sampled bool
+	// ratio is the fallback ratio the trace was sampled by, 0 when the trace was kept by a rule condition or by default
+	ratio float64
+}
+
+// apply records the effective sampling probability on the spans of a trace kept by a fallback ratio
+func (sd samplingDecision) apply(td ptrace.Traces) {
+	if sd.ratio <= 0 {
+		return
+	}
+	probability := sd.ratio / 100
+
+	resources := td.ResourceSpans()
+	for r := 0; r < resources.Len(); r++ {
+		scopeSpans := resources.At(r).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				spans.At(k).Attributes().PutDouble(sampling.SamplingProbabilityAttribute, probability)
+			}
+		}
+	}
+}
+
+// fallbackRatio holds the highest fallback ratio of a rules scope and the rule it came from.
+// matched is tracked apart from the ratio, as a matching rule with a ratio of 0 drops the trace.
+type fallbackRatio struct {
+	matched bool
+	ratio   float64
+	rule    *Rule
+}
+
+func (fr *fallbackRatio) update(rule *Rule, ratio float64) {
+	if !fr.matched || ratio > fr.ratio {
+		fr.matched = true
+		fr.ratio = ratio
+		fr.rule = rule
+	}
+}
+
+func newSamplingProcessor(logger *zap.Logger, config *Config, nextConsumer consumer.Traces) *samplingProcessor {
+	adaptive := make(map[*Rule]*sampling.AdaptiveSampler)
+	for _, rules := range [][]Rule{config.GlobalRules, config.ServiceRules} {
+		for i := range rules {
+			if adaptiveRule, ok := rules[i].RuleDetails.(*sampling.AdaptiveRule); ok {
+				adaptive[&rules[i]] = sampling.NewAdaptiveSampler(adaptiveRule)
+			}
+		}
+	}
+
+	return &samplingProcessor{
+		logger:       logger,
+		config:       config,
+		nextConsumer: nextConsumer,
+		adaptive:     adaptive,
+		buffer:       newTraceBuffer(config.NumTraces),
+		decisions:    newDecisionCache(config.DecisionCacheSize),
+		now:          time.Now,
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// adaptiveRatios is observed by the adaptive ratio gauge of the telemetry
+func (sp *samplingProcessor) adaptiveRatios() map[*Rule]map[string]float64 {
+	ratios := make(map[*Rule]map[string]float64, len(sp.adaptive))
+	for rule, sampler := range sp.adaptive {
+		ratios[rule] = sampler.Ratios()
+	}
+	return ratios
+}
+
+func (sp *samplingProcessor) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: true}
+}
+
+func (sp *samplingProcessor) Start(_ context.Context, _ component.Host) error {
+	sp.wg.Add(1)
+	go sp.releaseLoop()
+	return nil
+}
+
+// Shutdown stops the release loop and evaluates the traces still held in memory, so they are not lost
+func (sp *samplingProcessor) Shutdown(ctx context.Context) error {
+	sp.stopOnce.Do(func() { close(sp.stopCh) })
+	sp.wg.Wait()
+
+	sp.mu.Lock()
+	batch := sp.decide(ctx, sp.buffer.popAll())
+	sp.mu.Unlock()
+
+	return sp.forward(ctx, batch)
+}
+
+// ConsumeTraces buffers the spans until their trace is evaluated. Spans of traces that were
+// already evaluated get the cached decision and are forwarded or dropped right away.
+func (sp *samplingProcessor) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	batch := ptrace.NewTraces()
+
+	sp.mu.Lock()
+	now := sp.now()
+	var evicted []*bufferedTrace
+	for traceID, trace := range splitByTraceID(td) {
+		if decision, found := sp.decisions.get(traceID); found {
+			sp.telemetry.recordLateSpans(ctx, trace.SpanCount(), decision.sampled)
+			if decision.sampled {
+				decision.apply(trace)
+				trace.ResourceSpans().MoveAndAppendTo(batch.ResourceSpans())
+			}
+			continue
+		}
+
+		if e := sp.buffer.add(traceID, trace, now); e != nil {
+			evicted = append(evicted, e)
+		}
+	}
+
+	if len(evicted) > 0 {
+		sp.telemetry.recordEvicted(ctx, len(evicted))
+		sp.decide(ctx, evicted).ResourceSpans().MoveAndAppendTo(batch.ResourceSpans())
+	}
+	sp.mu.Unlock()
+
+	return sp.forward(ctx, batch)
+}
+
+func (sp *samplingProcessor) releaseLoop() {
+	defer sp.wg.Done()
+
+	interval := sp.config.DecisionWait
+	if interval > maxReleaseInterval {
+		interval = maxReleaseInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sp.stopCh:
+			return
+		case <-ticker.C:
+			ctx := context.Background()
+			if err := sp.releaseExpired(ctx); err != nil {
+				sp.logger.Error("Failed to forward sampled traces", zap.Error(err))
+			}
+		}
+	}
 }
 
-func (sp *samplingProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
-	globalUnsatisfiedRatio := 0.0
-	serviceUnsatisfiedRatio := 0.0
-	EndpointUnsatisfiedRatio := 0.0
+// releaseExpired evaluates the traces whose decision wait is over and forwards the kept ones
+func (sp *samplingProcessor) releaseExpired(ctx context.Context) error {
+	sp.mu.Lock()
+	expired := sp.buffer.popExpired(sp.now().Add(-sp.config.DecisionWait))
+	batch := sp.decide(ctx, expired)
+	sp.mu.Unlock()
+
+	return sp.forward(ctx, batch)
+}
+
+// decide evaluates the traces, caches their decisions and returns the kept traces in a single batch. must be called with mu held.
+func (sp *samplingProcessor) decide(ctx context.Context, traces []*bufferedTrace) ptrace.Traces {
+	batch := ptrace.NewTraces()
+	for _, trace := range traces {
+		decision := sp.evaluate(ctx, trace.traceID, trace.td)
+		sp.decisions.put(trace.traceID, decision)
+		if decision.sampled {
+			decision.apply(trace.td)
+			trace.td.ResourceSpans().MoveAndAppendTo(batch.ResourceSpans())
+		}
+	}
+	return batch
+}
+
+func (sp *samplingProcessor) forward(ctx context.Context, batch ptrace.Traces) error {
+	if batch.ResourceSpans().Len() == 0 {
+		return nil
+	}
+	return sp.nextConsumer.ConsumeTraces(ctx, batch)
+}
+
+// bufferUsage is observed by the in memory gauges of the telemetry
+func (sp *samplingProcessor) bufferUsage() (traces int, spans int) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return sp.buffer.len(), sp.buffer.spans
+}
+
+// evaluate runs the rules on all the spans of a single trace
+func (sp *samplingProcessor) evaluate(ctx context.Context, traceID pcommon.TraceID, td ptrace.Traces) samplingDecision {
+	var (
+		globalUnsatisfied   fallbackRatio
+		serviceUnsatisfied  fallbackRatio
+		endpointUnsatisfied fallbackRatio
+	)
+
+	sp.telemetry.recordEvaluated(ctx, 1)
 
 	// Evaluate global rules first
-	for _, rule := range sp.config.GlobalRules {
+	for i := range sp.config.GlobalRules {
+		rule := &sp.config.GlobalRules[i]
 		switch r := rule.RuleDetails.(type) {
 		case *sampling.ErrorRule: //
+			filterMatch, conditionMatch := r.KeepTraceDecision(td)
+			if filterMatch {
+				if conditionMatch {
+					sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+					return samplingDecision{sampled: true}
+				} else {
+					globalUnsatisfied.update(rule, r.FallbackSamplingRatio)
+				}
+			}
+		case *sampling.SpanAttributeRule:
 			if r.KeepTraceDecision(td) {
-				return td, nil
+				sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+				return samplingDecision{sampled: true}
 			} else {
-				globalUnsatisfiedRatio = max(globalUnsatisfiedRatio, r.FallbackSamplingRatio)
+				globalUnsatisfied.update(rule, r.FallbackSamplingRatio)
+			}
+		case *sampling.AdaptiveRule:
+			filterMatch, conditionMatch, ratio := sp.adaptive[rule].KeepTraceDecision(td)
+			if filterMatch {
+				if conditionMatch {
+					sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+					return samplingDecision{sampled: true}
+				} else {
+					globalUnsatisfied.update(rule, ratio)
+				}
 			}
 		default:
 			sp.logger.Error("Unknown global rule details type", zap.String("rule", rule.Name))
 		}
 	}
 
-	// Placeholder for service rules
+	// Evaluate service rules
+	for i := range sp.config.ServiceRules {
+		rule := &sp.config.ServiceRules[i]
+		switch r := rule.RuleDetails.(type) {
+		case *sampling.ServiceNameRule:
+			filterMatch, conditionMatch := r.KeepTraceDecision(td)
+			if filterMatch {
+				if conditionMatch {
+					sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+					return samplingDecision{sampled: true}
+				} else {
+					serviceUnsatisfied.update(rule, r.SamplingRatio)
+				}
+			}
+		case *sampling.AdaptiveRule:
+			filterMatch, conditionMatch, ratio := sp.adaptive[rule].KeepTraceDecision(td)
+			if filterMatch {
+				if conditionMatch {
+					sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+					return samplingDecision{sampled: true}
+				} else {
+					serviceUnsatisfied.update(rule, ratio)
+				}
+			}
+		default:
+			sp.logger.Error("Unknown service rule details type", zap.String("rule", rule.Name))
+		}
+	}
 
 	// Evaluate endpoint rules
-	for _, rule := range sp.config.EndpointRules {
+	for i := range sp.config.EndpointRules {
+		rule := &sp.config.EndpointRules[i]
 		switch r := rule.RuleDetails.(type) {
 		case *sampling.HttpRouteLatencyRule:
 			filterMatch, conditionMatch := r.KeepTraceDecision(td)
 			if filterMatch {
 				if conditionMatch {
-					return td, nil
+					sp.telemetry.recordDecision(ctx, rule, reasonConditionMatched, 1, 0)
+					return samplingDecision{sampled: true}
 				} else {
-					EndpointUnsatisfiedRatio = max(EndpointUnsatisfiedRatio, r.FallbackSamplingRatio)
+					endpointUnsatisfied.update(rule, r.FallbackSamplingRatio)
 				}
 			}
 		default:
@@ -52,41 +320,31 @@ func (sp *samplingProcessor) processTraces(ctx context.Context, td ptrace.Traces
 		}
 	}
 
-	var finalUnsatisfiedRatio float64
+	var finalUnsatisfied fallbackRatio
 	// Evaluate against the most specific unsatisfied ratio
-	if EndpointUnsatisfiedRatio > 0.0 {
-		finalUnsatisfiedRatio = EndpointUnsatisfiedRatio
+	if endpointUnsatisfied.matched {
+		finalUnsatisfied = endpointUnsatisfied
 	} else {
-		if serviceUnsatisfiedRatio > 0.0 {
-			finalUnsatisfiedRatio = serviceUnsatisfiedRatio
+		if serviceUnsatisfied.matched {
+			finalUnsatisfied = serviceUnsatisfied
 		} else {
-			if globalUnsatisfiedRatio > 0.0 {
-				finalUnsatisfiedRatio = globalUnsatisfiedRatio
+			if globalUnsatisfied.matched {
+				finalUnsatisfied = globalUnsatisfied
 			} else {
 				// None of the rules matched, trace is sampled by default
-				return td, nil
+				sp.telemetry.recordDecision(ctx, nil, reasonNoRuleMatched, 1, 0)
+				return samplingDecision{sampled: true}
 			}
 		}
 	}
 
-	// Sample the trace based on the final unsatisfied ratio
-	if finalUnsatisfiedRatio > 0.0 && (rand.Float64()*100) < finalUnsatisfiedRatio {
-		return td, nil
+	// Sample the trace based on the final unsatisfied ratio. The decision depends only on the trace id,
+	// so every gateway replica ends up with the same decision for the trace.
+	if sampling.IsTraceIDSampled(traceID, finalUnsatisfied.ratio) {
+		sp.telemetry.recordDecision(ctx, finalUnsatisfied.rule, reasonFallbackRatio, 1, 0)
+		return samplingDecision{sampled: true, ratio: finalUnsatisfied.ratio}
 	}
 
-	sp.removeAllSpans(&td)
-	return td, nil
-}
-
-////
-
-func max(a, b float64) float64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-func (sp *samplingProcessor) removeAllSpans(td *ptrace.Traces) {
-	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool { return true })
+	sp.telemetry.recordDecision(ctx, finalUnsatisfied.rule, reasonFallbackRatio, 0, 1)
+	return samplingDecision{sampled: false, ratio: finalUnsatisfied.ratio}
 }
@@ -0,0 +1,249 @@
+package odigossamplingprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossamplingprocessor/internal/sampling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newTestProcessor(t *testing.T, config *Config) (*samplingProcessor, *consumertest.TracesSink, *time.Time) {
+	sink := new(consumertest.TracesSink)
+	proc := newSamplingProcessor(zap.NewNop(), config, sink)
+
+	telemetry, err := newSamplingTelemetry(componenttest.NewNopTelemetrySettings(), proc.bufferUsage, proc.adaptiveRatios)
+	require.NoError(t, err)
+	proc.telemetry = telemetry
+
+	now := time.Unix(0, 0)
+	proc.now = func() time.Time { return now }
+	return proc, sink, &now
+}
+
+func newSpans(traceID byte, spans int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	ss := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty()
+	for i := 0; i < spans; i++ {
+		ss.Spans().AppendEmpty().SetTraceID(pcommon.TraceID{traceID})
+	}
+	return td
+}
+
+func TestProcessorHoldsTraceUntilDecisionWait(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.DecisionWait = 10 * time.Second
+	proc, sink, now := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(1, 2)))
+	*now = now.Add(5 * time.Second)
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(1, 1)))
+	require.NoError(t, proc.releaseExpired(ctx))
+	assert.Equal(t, 0, sink.SpanCount())
+
+	traces, spans := proc.bufferUsage()
+	assert.Equal(t, 1, traces)
+	assert.Equal(t, 3, spans)
+
+	// the wait starts at the first span of the trace
+	*now = now.Add(5 * time.Second)
+	require.NoError(t, proc.releaseExpired(ctx))
+	assert.Equal(t, 3, sink.SpanCount())
+
+	traces, spans = proc.bufferUsage()
+	assert.Equal(t, 0, traces)
+	assert.Equal(t, 0, spans)
+}
+
+func TestProcessorLateSpansUseCachedDecision(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	proc, sink, now := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(1, 1)))
+	*now = now.Add(config.DecisionWait)
+	require.NoError(t, proc.releaseExpired(ctx))
+	assert.Equal(t, 1, sink.SpanCount())
+
+	// kept trace, the late span is forwarded right away
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(1, 1)))
+	assert.Equal(t, 2, sink.SpanCount())
+
+	// dropped trace, the late span is dropped right away
+	proc.decisions.put(pcommon.TraceID{2}, samplingDecision{sampled: false, ratio: 10})
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(2, 1)))
+	assert.Equal(t, 2, sink.SpanCount())
+
+	traces, _ := proc.bufferUsage()
+	assert.Equal(t, 0, traces)
+}
+
+func TestProcessorEvictsOldestTrace(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.NumTraces = 1
+	proc, sink, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(1, 1)))
+	require.NoError(t, proc.ConsumeTraces(ctx, newSpans(2, 2)))
+	assert.Equal(t, 1, sink.SpanCount())
+
+	// spans flushed on shutdown are not lost
+	require.NoError(t, proc.Shutdown(ctx))
+	assert.Equal(t, 3, sink.SpanCount())
+}
+
+func TestDecisionCacheReplacesOldest(t *testing.T) {
+	cache := newDecisionCache(2)
+	cache.put(pcommon.TraceID{1}, samplingDecision{sampled: true})
+	cache.put(pcommon.TraceID{2}, samplingDecision{sampled: true})
+	cache.put(pcommon.TraceID{3}, samplingDecision{sampled: false})
+
+	_, found := cache.get(pcommon.TraceID{1})
+	assert.False(t, found)
+	decision, found := cache.get(pcommon.TraceID{3})
+	assert.True(t, found)
+	assert.False(t, decision.sampled)
+}
+
+func TestProcessorServiceRuleZeroRatioDropsTrace(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.ServiceRules = []Rule{{
+		Name:        "drop-noisy",
+		Type:        "service_name",
+		RuleDetails: &sampling.ServiceNameRule{ServiceName: "noisy", SamplingRatio: 0},
+	}}
+	proc, _, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	noisy := newSpans(1, 1)
+	noisy.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "noisy")
+	decision := proc.evaluate(ctx, pcommon.TraceID{1}, noisy)
+	assert.False(t, decision.sampled)
+
+	// traces of other services do not match the rule, and are kept by default
+	other := newSpans(2, 1)
+	other.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "frontend")
+	decision = proc.evaluate(ctx, pcommon.TraceID{2}, other)
+	assert.True(t, decision.sampled)
+}
+
+// newServiceSpan returns a trace with a single span of the service on the route, lasting the duration
+func newServiceSpan(traceID byte, service string, route string, duration time.Duration, status ptrace.StatusCode) ptrace.Traces {
+	td := newSpans(traceID, 1)
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", service)
+	span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	span.Attributes().PutStr("http.route", route)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, 0)))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, 0).Add(duration)))
+	span.Status().SetCode(status)
+	return td
+}
+
+func TestProcessorErrorRuleZeroFallbackDropsNonErrorTraces(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.GlobalRules = []Rule{{
+		Name:        "errors-only",
+		Type:        "error",
+		RuleDetails: map[string]interface{}{"fallback_sampling_ratio": 0},
+	}}
+	require.NoError(t, config.Validate())
+	proc, _, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	decision := proc.evaluate(ctx, pcommon.TraceID{1}, newServiceSpan(1, "frontend", "/buy", time.Millisecond, ptrace.StatusCodeError))
+	assert.True(t, decision.sampled)
+
+	// every trace matches a global rule, so a trace without errors is dropped by the fallback ratio of 0
+	decision = proc.evaluate(ctx, pcommon.TraceID{2}, newServiceSpan(2, "frontend", "/buy", time.Millisecond, ptrace.StatusCodeOk))
+	assert.False(t, decision.sampled)
+}
+
+func TestProcessorScopedErrorRuleIgnoresOtherServices(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.GlobalRules = []Rule{{
+		Name: "checkout-errors",
+		Type: "error",
+		RuleDetails: map[string]interface{}{
+			"service_name":            "checkout",
+			"fallback_sampling_ratio": 0,
+		},
+	}}
+	require.NoError(t, config.Validate())
+	proc, _, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	decision := proc.evaluate(ctx, pcommon.TraceID{1}, newServiceSpan(1, "checkout", "/buy", time.Millisecond, ptrace.StatusCodeOk))
+	assert.False(t, decision.sampled)
+
+	// the rule has no opinion on traces without a span of its service, so its fallback ratio does not apply
+	decision = proc.evaluate(ctx, pcommon.TraceID{2}, newServiceSpan(2, "frontend", "/buy", time.Millisecond, ptrace.StatusCodeOk))
+	assert.True(t, decision.sampled)
+}
+
+func TestProcessorLatencyRuleZeroFallbackDropsFastTraces(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.EndpointRules = []Rule{{
+		Name: "slow-checkout",
+		Type: "http_latency",
+		RuleDetails: map[string]interface{}{
+			"threshold":               1000,
+			"http_route":              "/buy",
+			"service_name":            "frontend",
+			"fallback_sampling_ratio": 0,
+		},
+	}}
+	require.NoError(t, config.Validate())
+	proc, _, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	decision := proc.evaluate(ctx, pcommon.TraceID{1}, newServiceSpan(1, "frontend", "/buy", 2*time.Second, ptrace.StatusCodeUnset))
+	assert.True(t, decision.sampled)
+
+	// a trace on the endpoint below the threshold is dropped by the fallback ratio of 0
+	decision = proc.evaluate(ctx, pcommon.TraceID{2}, newServiceSpan(2, "frontend", "/buy", time.Millisecond, ptrace.StatusCodeUnset))
+	assert.False(t, decision.sampled)
+
+	// traces of other endpoints do not match the rule, and are kept by default
+	decision = proc.evaluate(ctx, pcommon.TraceID{3}, newServiceSpan(3, "frontend", "/cart", time.Millisecond, ptrace.StatusCodeUnset))
+	assert.True(t, decision.sampled)
+}
+
+func TestProcessorGlobalAdaptiveRule(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.GlobalRules = []Rule{{
+		Name:        "adaptive",
+		Type:        "adaptive",
+		RuleDetails: map[string]interface{}{"target_traces_per_second": 10},
+	}}
+	require.NoError(t, config.Validate())
+	proc, _, _ := newTestProcessor(t, config)
+	ctx := context.Background()
+
+	td := newSpans(1, 1)
+	td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "frontend")
+	decision := proc.evaluate(ctx, pcommon.TraceID{1}, td)
+	assert.True(t, decision.sampled)
+
+	// the trace is counted in the throughput of its service
+	ratios := proc.adaptiveRatios()[&config.GlobalRules[0]]
+	assert.Equal(t, map[string]float64{"frontend": 100}, ratios)
+}
+
+func TestConfigRejectsEndpointAdaptiveRule(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.EndpointRules = []Rule{{
+		Name:        "adaptive",
+		Type:        "adaptive",
+		RuleDetails: map[string]interface{}{"target_traces_per_second": 10},
+	}}
+	assert.ErrorContains(t, config.Validate(), "not supported in endpoint rules")
+}
@@ -0,0 +1,181 @@
+package odigossamplingprocessor
+
+import (
+	"context"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossamplingprocessor/internal/metadata"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Decision reasons reported on the kept/dropped traces metrics
+const (
+	reasonConditionMatched = "condition_matched"
+	reasonFallbackRatio    = "fallback_ratio"
+	reasonNoRuleMatched    = "no_rule_matched"
+)
+
+const (
+	ruleNameKey    = attribute.Key("rule")
+	ruleTypeKey    = attribute.Key("rule_type")
+	reasonKey      = attribute.Key("reason")
+	serviceNameKey = attribute.Key("service_name")
+	sampledKey     = attribute.Key("sampled")
+	unitTraceName  = "{traces}"
+	unitSpanName   = "{spans}"
+)
+
+type samplingTelemetry struct {
+	tracesEvaluated metric.Int64Counter
+	tracesKept      metric.Int64Counter
+	tracesDropped   metric.Int64Counter
+	adaptiveRatio   metric.Float64ObservableGauge
+	tracesEvicted   metric.Int64Counter
+	lateSpans       metric.Int64Counter
+	tracesInMemory  metric.Int64ObservableGauge
+	spansInMemory   metric.Int64ObservableGauge
+}
+
+// bufferUsage returns the number of traces and spans currently held in memory
+type bufferUsage func() (traces int, spans int)
+
+// adaptiveRatios returns the current ratio of each service, by the adaptive rule tracking it
+type adaptiveRatios func() map[*Rule]map[string]float64
+
+func newSamplingTelemetry(settings component.TelemetrySettings, usage bufferUsage, ratios adaptiveRatios) (*samplingTelemetry, error) {
+	meter := metadata.Meter(settings)
+
+	tracesEvaluated, err := meter.Int64Counter(
+		"odigossampling_traces_evaluated",
+		metric.WithDescription("Number of traces evaluated by the sampling rules"),
+		metric.WithUnit(unitTraceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracesKept, err := meter.Int64Counter(
+		"odigossampling_traces_kept",
+		metric.WithDescription("Number of traces kept, by the rule and the reason of the decision"),
+		metric.WithUnit(unitTraceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracesDropped, err := meter.Int64Counter(
+		"odigossampling_traces_dropped",
+		metric.WithDescription("Number of traces dropped, by the rule and the reason of the decision"),
+		metric.WithUnit(unitTraceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	adaptiveRatio, err := meter.Float64ObservableGauge(
+		"odigossampling_adaptive_sampling_ratio",
+		metric.WithDescription("Current sampling ratio of each service, as adjusted by the adaptive rules"),
+		metric.WithUnit("%"),
+		metric.WithFloat64Callback(func(_ context.Context, observer metric.Float64Observer) error {
+			observeAdaptiveRatios(observer, ratios())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracesEvicted, err := meter.Int64Counter(
+		"odigossampling_traces_evicted",
+		metric.WithDescription("Number of traces evaluated before the end of the decision wait because the maximum number of traces in memory was reached"),
+		metric.WithUnit(unitTraceName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lateSpans, err := meter.Int64Counter(
+		"odigossampling_late_spans",
+		metric.WithDescription("Number of spans received after their trace was evaluated, by the cached decision"),
+		metric.WithUnit(unitSpanName),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tracesInMemory, err := meter.Int64ObservableGauge(
+		"odigossampling_traces_in_memory",
+		metric.WithDescription("Number of traces held in memory waiting for a sampling decision"),
+		metric.WithUnit(unitTraceName),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			traces, _ := usage()
+			observer.Observe(int64(traces))
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	spansInMemory, err := meter.Int64ObservableGauge(
+		"odigossampling_spans_in_memory",
+		metric.WithDescription("Number of spans held in memory waiting for a sampling decision"),
+		metric.WithUnit(unitSpanName),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			_, spans := usage()
+			observer.Observe(int64(spans))
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &samplingTelemetry{
+		tracesEvaluated: tracesEvaluated,
+		tracesKept:      tracesKept,
+		tracesDropped:   tracesDropped,
+		adaptiveRatio:   adaptiveRatio,
+		tracesEvicted:   tracesEvicted,
+		lateSpans:       lateSpans,
+		tracesInMemory:  tracesInMemory,
+		spansInMemory:   spansInMemory,
+	}, nil
+}
+
+func observeAdaptiveRatios(observer metric.Float64Observer, ratios map[*Rule]map[string]float64) {
+	for rule, serviceRatios := range ratios {
+		for service, ratio := range serviceRatios {
+			observer.Observe(ratio, metric.WithAttributes(ruleNameKey.String(rule.Name), serviceNameKey.String(service)))
+		}
+	}
+}
+
+func (st *samplingTelemetry) recordEvaluated(ctx context.Context, traces int) {
+	st.tracesEvaluated.Add(ctx, int64(traces))
+}
+
+// recordDecision reports kept and dropped traces, rule is nil when no rule matched the traces
+func (st *samplingTelemetry) recordDecision(ctx context.Context, rule *Rule, reason string, kept, dropped int) {
+	attrs := []attribute.KeyValue{reasonKey.String(reason)}
+	if rule != nil {
+		attrs = append(attrs, ruleNameKey.String(rule.Name), ruleTypeKey.String(rule.Type))
+	}
+	opt := metric.WithAttributes(attrs...)
+
+	if kept > 0 {
+		st.tracesKept.Add(ctx, int64(kept), opt)
+	}
+	if dropped > 0 {
+		st.tracesDropped.Add(ctx, int64(dropped), opt)
+	}
+}
+
+func (st *samplingTelemetry) recordEvicted(ctx context.Context, traces int) {
+	st.tracesEvicted.Add(ctx, int64(traces))
+}
+
+func (st *samplingTelemetry) recordLateSpans(ctx context.Context, spans int, sampled bool) {
+	st.lateSpans.Add(ctx, int64(spans), metric.WithAttributes(sampledKey.Bool(sampled)))
+}
@@ -0,0 +1,94 @@
+package odigossamplingprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossamplingprocessor/internal/sampling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectCounter returns the values of the counter keyed by the attribute set of each data point
+func collectCounter(t *testing.T, reader sdkmetric.Reader, name string) map[attribute.Distinct]int64 {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	values := map[attribute.Distinct]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "%s is not an int64 counter", name)
+			for _, dp := range sum.DataPoints {
+				values[dp.Attributes.Equivalent()] = dp.Value
+			}
+		}
+	}
+	return values
+}
+
+func attributeSet(kvs ...attribute.KeyValue) attribute.Distinct {
+	set := attribute.NewSet(kvs...)
+	return set.Equivalent()
+}
+
+func TestTelemetryRecordsDecisions(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.ServiceRules = []Rule{
+		{
+			Name:        "keep-checkout",
+			Type:        "service_name",
+			RuleDetails: &sampling.ServiceNameRule{ServiceName: "checkout", SamplingRatio: 100},
+		},
+		{
+			Name:        "drop-noisy",
+			Type:        "service_name",
+			RuleDetails: &sampling.ServiceNameRule{ServiceName: "noisy", SamplingRatio: 0},
+		},
+	}
+	proc, _, _ := newTestProcessor(t, config)
+
+	reader := sdkmetric.NewManualReader()
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	telemetry, err := newSamplingTelemetry(settings, proc.bufferUsage, proc.adaptiveRatios)
+	require.NoError(t, err)
+	proc.telemetry = telemetry
+
+	ctx := context.Background()
+	for i, service := range []string{"checkout", "noisy", "noisy", "frontend"} {
+		td := newSpans(byte(i+1), 1)
+		td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", service)
+		proc.evaluate(ctx, pcommon.TraceID{byte(i + 1)}, td)
+	}
+
+	evaluated := collectCounter(t, reader, "odigossampling_traces_evaluated")
+	assert.Equal(t, map[attribute.Distinct]int64{attributeSet(): 4}, evaluated)
+
+	kept := collectCounter(t, reader, "odigossampling_traces_kept")
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attributeSet(
+			reasonKey.String(reasonConditionMatched),
+			ruleNameKey.String("keep-checkout"),
+			ruleTypeKey.String("service_name"),
+		): 1,
+		attributeSet(reasonKey.String(reasonNoRuleMatched)): 1,
+	}, kept)
+
+	dropped := collectCounter(t, reader, "odigossampling_traces_dropped")
+	assert.Equal(t, map[attribute.Distinct]int64{
+		attributeSet(
+			reasonKey.String(reasonFallbackRatio),
+			ruleNameKey.String("drop-noisy"),
+			ruleTypeKey.String("service_name"),
+		): 2,
+	}, dropped)
+}
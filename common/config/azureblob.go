@@ -9,6 +9,10 @@ import (
 const (
 	blobAccountName   = "AZURE_BLOB_ACCOUNT_NAME"
 	blobContainerName = "AZURE_BLOB_CONTAINER_NAME"
+	blobMarshaler     = "AZURE_BLOB_MARSHALER"
+	blobKeyTemplate   = "AZURE_BLOB_KEY_TEMPLATE"
+	// prefix of the AZURE_BLOB_MAX_OBJECT_BYTES, AZURE_BLOB_MAX_OBJECT_AGE and AZURE_BLOB_COMPRESSION keys
+	blobObjectKeyPrefix = "AZURE_BLOB_"
 )
 
 var (
@@ -33,14 +37,35 @@ func (a *AzureBlobStorage) ModifyConfig(dest ExporterConfigurer, currentConfig *
 		return ErrorMissingAzureBlobContainerName
 	}
 
+	marshaler, ok := dest.GetConfig()[blobMarshaler]
+	if !ok {
+		marshaler = "otlp_json"
+	}
+	if marshaler != "otlp_json" && marshaler != "otlp_proto" && marshaler != "parquet" {
+		return errors.New("Invalid marshaller specified, gateway will not be configured for Azure Blob Storage")
+	}
+	// parquet files hold spans and log records only
+	if marshaler == "parquet" && isMetricsEnabled(dest) {
+		return errors.New("The parquet marshaller does not support metrics, disable metrics or use another marshaller for Azure Blob Storage")
+	}
+
+	blobConfig := GenericMap{
+		"account_name": accountName,
+		"container":    containerName,
+	}
+	if keyTemplate, ok := dest.GetConfig()[blobKeyTemplate]; ok && keyTemplate != "" {
+		blobConfig["key_template"] = keyTemplate
+	}
+	if err := applyObjectBatching(dest.GetConfig(), blobObjectKeyPrefix, blobConfig); err != nil {
+		return err
+	}
+
 	exporterName := "azureblobstorage/" + dest.GetID()
 
 	if isLoggingEnabled(dest) {
 		currentConfig.Exporters[exporterName] = GenericMap{
-			"blob": GenericMap{
-				"account_name": accountName,
-				"container":    containerName,
-			},
+			"blob":           blobConfig,
+			"marshaler_name": marshaler,
 		}
 
 		logsPipelineName := "logs/azureblobstorage-" + dest.GetID()
@@ -49,12 +74,22 @@ func (a *AzureBlobStorage) ModifyConfig(dest ExporterConfigurer, currentConfig *
 		}
 	}
 
+	if isMetricsEnabled(dest) {
+		currentConfig.Exporters[exporterName] = GenericMap{
+			"blob":           blobConfig,
+			"marshaler_name": marshaler,
+		}
+
+		metricsPipelineName := "metrics/azureblobstorage-" + dest.GetID()
+		currentConfig.Service.Pipelines[metricsPipelineName] = Pipeline{
+			Exporters: []string{exporterName},
+		}
+	}
+
 	if isTracingEnabled(dest) {
 		currentConfig.Exporters[exporterName] = GenericMap{
-			"blob": GenericMap{
-				"account_name": accountName,
-				"container":    containerName,
-			},
+			"blob":           blobConfig,
+			"marshaler_name": marshaler,
 		}
 
 		tracesPipelineName := "traces/azureblobstorage-" + dest.GetID()
@@ -0,0 +1,264 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+// advanced options that can be set on every destination to tune the exporters generated for it
+const (
+	exporterQueueSizeKey            = "EXPORTER_QUEUE_SIZE"
+	exporterQueueConsumersKey       = "EXPORTER_QUEUE_CONSUMERS"
+	exporterRetryInitialIntervalKey = "EXPORTER_RETRY_INITIAL_INTERVAL"
+	exporterRetryMaxIntervalKey     = "EXPORTER_RETRY_MAX_INTERVAL"
+	exporterRetryMaxElapsedTimeKey  = "EXPORTER_RETRY_MAX_ELAPSED_TIME"
+	exporterTimeoutKey              = "EXPORTER_TIMEOUT"
+	exporterCompressionKey          = "EXPORTER_COMPRESSION"
+)
+
+var supportedCompressions = []string{"gzip", "zstd", "snappy", "zlib", "deflate", "none"}
+
+// exporters without any of the advanced options, as they are not built on the exporter helper
+var exportersWithoutOptions = []string{"awss3", "debug", "logging", "sentry"}
+
+// the exporters that do not support each advanced option, on top of exportersWithoutOptions
+var (
+	exportersWithoutQueue   = exporterSet("prometheusremotewrite")
+	exportersWithoutRetry   = exporterSet("elasticsearch", "googlecloud")
+	exportersWithoutTimeout = exporterSet()
+	// prometheus remote write requests are always snappy compressed by the protocol,
+	// and the storage exporters compress the objects they write by the compression field of the destination
+	exportersWithoutCompression = exporterSet("azureblobstorage", "clickhouse", "elasticsearch", "googlecloud", "googlecloudstorage", "prometheusremotewrite")
+)
+
+// the exporters not supporting each advanced option, keyed by the destination config key of the option
+var exportersWithoutOption = map[string]map[string]bool{
+	exporterQueueSizeKey:            exportersWithoutQueue,
+	exporterQueueConsumersKey:       exportersWithoutQueue,
+	exporterRetryInitialIntervalKey: exportersWithoutRetry,
+	exporterRetryMaxIntervalKey:     exportersWithoutRetry,
+	exporterRetryMaxElapsedTimeKey:  exportersWithoutRetry,
+	exporterTimeoutKey:              exportersWithoutTimeout,
+	exporterCompressionKey:          exportersWithoutCompression,
+}
+
+// the types of the exporters added for the destination types that do not only add otlp exporters
+var destinationExporterTypes = map[common.DestinationType][]string{
+	common.AWSS3DestinationType:                  {"awss3"},
+	common.AzureBlobDestinationType:              {"azureblobstorage"},
+	common.ClickhouseDestinationType:             {"clickhouse"},
+	common.CoralogixDestinationType:              {"coralogix"},
+	common.DatadogDestinationType:                {"datadog"},
+	common.DebugDestinationType:                  {"debug"},
+	common.ElasticsearchDestinationType:          {"elasticsearch"},
+	common.GCSDestinationType:                    {"googlecloudstorage"},
+	common.GoogleCloudDestinationType:            {"googlecloud"},
+	common.GrafanaCloudLokiDestinationType:       {"loki"},
+	common.GrafanaCloudPrometheusDestinationType: {"prometheusremotewrite"},
+	common.LogzioDestinationType:                 {"logzio", "prometheusremotewrite"},
+	common.LokiDestinationType:                   {"loki"},
+	common.OpsVerseDestinationType:               {"otlp", "prometheusremotewrite", "loki"},
+	common.PrometheusDestinationType:             {"prometheusremotewrite"},
+	common.QrynDestinationType:                   {"otlp", "prometheusremotewrite", "loki"},
+	common.SentryDestinationType:                 {"sentry"},
+	common.SplunkDestinationType:                 {"sapm"},
+}
+
+func exporterSet(exporterTypes ...string) map[string]bool {
+	set := make(map[string]bool, len(exporterTypes)+len(exportersWithoutOptions))
+	for _, exporterType := range append(exporterTypes, exportersWithoutOptions...) {
+		set[exporterType] = true
+	}
+	return set
+}
+
+// ExporterOptionSupported tells if the advanced option, named by its destination config key,
+// is supported by all the exporters added for the destination type
+func ExporterOptionSupported(destType common.DestinationType, key string) bool {
+	exporterTypes, ok := destinationExporterTypes[destType]
+	if !ok {
+		exporterTypes = []string{"otlp"}
+	}
+	for _, exporterType := range exporterTypes {
+		if exportersWithoutOption[key][exporterType] {
+			return false
+		}
+	}
+	return true
+}
+
+type exporterOptions struct {
+	sendingQueue   GenericMap
+	retryOnFailure GenericMap
+	timeout        string
+	compression    string
+	// keys are the destination config keys of the options set, sorted
+	keys []string
+}
+
+func (o *exporterOptions) empty() bool {
+	return len(o.keys) == 0
+}
+
+func parseExporterOptions(config map[string]string) (*exporterOptions, error) {
+	options := &exporterOptions{
+		sendingQueue:   GenericMap{},
+		retryOnFailure: GenericMap{},
+	}
+
+	for key, field := range map[string]string{exporterQueueSizeKey: "queue_size", exporterQueueConsumersKey: "num_consumers"} {
+		value, err := parsePositiveInt(config, key)
+		if err != nil {
+			return nil, err
+		}
+		if value > 0 {
+			options.sendingQueue[field] = value
+			options.keys = append(options.keys, key)
+		}
+	}
+
+	retryIntervals := map[string]string{
+		exporterRetryInitialIntervalKey: "initial_interval",
+		exporterRetryMaxIntervalKey:     "max_interval",
+		exporterRetryMaxElapsedTimeKey:  "max_elapsed_time",
+	}
+	for key, field := range retryIntervals {
+		// zero max_elapsed_time retries forever
+		value, err := parseDuration(config, key, key == exporterRetryMaxElapsedTimeKey)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			options.retryOnFailure[field] = value
+			options.keys = append(options.keys, key)
+		}
+	}
+
+	timeout, err := parseDuration(config, exporterTimeoutKey, false)
+	if err != nil {
+		return nil, err
+	}
+	options.timeout = timeout
+	if timeout != "" {
+		options.keys = append(options.keys, exporterTimeoutKey)
+	}
+
+	if compression := strings.TrimSpace(config[exporterCompressionKey]); compression != "" {
+		valid := false
+		for _, supported := range supportedCompressions {
+			if compression == supported {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("%s must be one of %s, got %q", exporterCompressionKey, strings.Join(supportedCompressions, ", "), compression)
+		}
+		options.compression = compression
+		options.keys = append(options.keys, exporterCompressionKey)
+	}
+
+	sort.Strings(options.keys)
+	return options, nil
+}
+
+func parsePositiveInt(config map[string]string, key string) (int, error) {
+	raw := strings.TrimSpace(config[key])
+	if raw == "" {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("%s must be a positive integer, got %q", key, raw)
+	}
+	return value, nil
+}
+
+func parseDuration(config map[string]string, key string, allowZero bool) (string, error) {
+	raw := strings.TrimSpace(config[key])
+	if raw == "" {
+		return "", nil
+	}
+	value, err := time.ParseDuration(raw)
+	if err != nil || value < 0 || (value == 0 && !allowZero) {
+		return "", fmt.Errorf("%s must be a positive duration such as 5s or 1m, got %q", key, raw)
+	}
+	return value.String(), nil
+}
+
+// applyExporterOptions merges the advanced options set on the destination into the exporters added for it,
+// overriding the defaults of the configer
+func applyExporterOptions(dest ExporterConfigurer, exporterNames []string, currentConfig *Config) error {
+	options, err := parseExporterOptions(dest.GetConfig())
+	if err != nil {
+		return err
+	}
+	if options.empty() {
+		return nil
+	}
+
+	sort.Strings(exporterNames)
+	for _, exporterName := range exporterNames {
+		exporterType, _, _ := strings.Cut(exporterName, "/")
+		for _, key := range options.keys {
+			if exportersWithoutOption[key][exporterType] {
+				return fmt.Errorf("%s is not supported by the %s exporter", key, exporterType)
+			}
+		}
+
+		exporterConfig, err := exporterConfigMap(currentConfig, exporterName)
+		if err != nil {
+			return err
+		}
+
+		if len(options.sendingQueue) > 0 {
+			sendingQueue := mergeExporterSection(exporterConfig, "sending_queue", options.sendingQueue)
+			sendingQueue["enabled"] = true
+		}
+		if len(options.retryOnFailure) > 0 {
+			retryOnFailure := mergeExporterSection(exporterConfig, "retry_on_failure", options.retryOnFailure)
+			retryOnFailure["enabled"] = true
+		}
+		if options.timeout != "" {
+			exporterConfig["timeout"] = options.timeout
+		}
+		if options.compression != "" {
+			exporterConfig["compression"] = options.compression
+		}
+	}
+
+	return nil
+}
+
+// mergeExporterSection sets the values on the named section of the exporter config, keeping the values already there
+func mergeExporterSection(exporterConfig GenericMap, section string, values GenericMap) GenericMap {
+	sectionConfig, ok := exporterConfig[section].(GenericMap)
+	if !ok {
+		sectionConfig = GenericMap{}
+	}
+	for key, value := range values {
+		sectionConfig[key] = value
+	}
+	exporterConfig[section] = sectionConfig
+	return sectionConfig
+}
+
+// exporterConfigMap returns the config of the exporter as a map that can be modified in place
+func exporterConfigMap(currentConfig *Config, exporterName string) (GenericMap, error) {
+	switch config := currentConfig.Exporters[exporterName].(type) {
+	case GenericMap:
+		return config, nil
+	case struct{}, nil:
+		// exporters configured only by defaults
+		exporterConfig := GenericMap{}
+		currentConfig.Exporters[exporterName] = exporterConfig
+		return exporterConfig, nil
+	default:
+		return nil, fmt.Errorf("cannot modify the config of exporter %s", exporterName)
+	}
+}
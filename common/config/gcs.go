@@ -9,6 +9,10 @@ import (
 const (
 	defaultGCSBucket = "odigos-otlp"
 	gcsBucketKey     = "GCS_BUCKET"
+	gcsMarshalerKey  = "GCS_MARSHALER"
+	gcsKeyTemplate   = "GCS_KEY_TEMPLATE"
+	// prefix of the GCS_MAX_OBJECT_BYTES, GCS_MAX_OBJECT_AGE and GCS_COMPRESSION keys
+	gcsObjectKeyPrefix = "GCS_"
 )
 
 type GoogleCloudStorage struct{}
@@ -19,7 +23,7 @@ func (g *GoogleCloudStorage) DestType() common.DestinationType {
 
 func (g *GoogleCloudStorage) ModifyConfig(dest ExporterConfigurer, currentConfig *Config) error {
 
-	if !isTracingEnabled(dest) && !isLoggingEnabled(dest) {
+	if !isTracingEnabled(dest) && !isLoggingEnabled(dest) && !isMetricsEnabled(dest) {
 		return errors.New("GoogleCloudStorage is not enabled for any supported signals, skipping")
 	}
 
@@ -29,11 +33,32 @@ func (g *GoogleCloudStorage) ModifyConfig(dest ExporterConfigurer, currentConfig
 		bucket = defaultGCSBucket
 	}
 
+	marshaler, ok := dest.GetConfig()[gcsMarshalerKey]
+	if !ok {
+		marshaler = "otlp_json"
+	}
+	if marshaler != "otlp_json" && marshaler != "otlp_proto" && marshaler != "parquet" {
+		return errors.New("Invalid marshaller specified, gateway will not be configured for GoogleCloudStorage")
+	}
+	// parquet files hold spans and log records only
+	if marshaler == "parquet" && isMetricsEnabled(dest) {
+		return errors.New("The parquet marshaller does not support metrics, disable metrics or use another marshaller for GoogleCloudStorage")
+	}
+
+	gcsConfig := GenericMap{
+		"bucket": bucket,
+	}
+	if keyTemplate, ok := dest.GetConfig()[gcsKeyTemplate]; ok && keyTemplate != "" {
+		gcsConfig["key_template"] = keyTemplate
+	}
+	if err := applyObjectBatching(dest.GetConfig(), gcsObjectKeyPrefix, gcsConfig); err != nil {
+		return err
+	}
+
 	exporterName := "googlecloudstorage/" + dest.GetID()
 	currentConfig.Exporters[exporterName] = GenericMap{
-		"pcs": GenericMap{
-			"bucket": bucket,
-		},
+		"gcs":            gcsConfig,
+		"marshaler_name": marshaler,
 	}
 
 	if isLoggingEnabled(dest) {
@@ -43,6 +68,13 @@ func (g *GoogleCloudStorage) ModifyConfig(dest ExporterConfigurer, currentConfig
 		}
 	}
 
+	if isMetricsEnabled(dest) {
+		metricsPipelineName := "metrics/gcs-" + dest.GetID()
+		currentConfig.Service.Pipelines[metricsPipelineName] = Pipeline{
+			Exporters: []string{exporterName},
+		}
+	}
+
 	if isTracingEnabled(dest) {
 		tracesPipelineName := "traces/gcs-" + dest.GetID()
 		currentConfig.Service.Pipelines[tracesPipelineName] = Pipeline{
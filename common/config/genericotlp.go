@@ -23,17 +23,20 @@ func (g *GenericOTLP) ModifyConfig(dest ExporterConfigurer, currentConfig *Confi
 		return errors.New("Generic OTLP gRPC endpoint not specified, gateway will not be configured for otlp")
 	}
 
-	grpcEndpoint, err := parseUnencryptedOtlpGrpcUrl(url)
+	grpcEndpoint, schemeTLS, err := parseOtlpGrpcUrl(url)
 	if err != nil {
 		return errors.Join(err, errors.New("otlp endpoint invalid, gateway will not be configured for otlp"))
 	}
 
+	tlsConfig, err := getOtlpGrpcTLSConfig(dest, schemeTLS)
+	if err != nil {
+		return errors.Join(err, errors.New("otlp tls options invalid, gateway will not be configured for otlp"))
+	}
+
 	genericOtlpExporterName := "otlp/generic-" + dest.GetID()
 	currentConfig.Exporters[genericOtlpExporterName] = GenericMap{
 		"endpoint": grpcEndpoint,
-		"tls": GenericMap{
-			"insecure": true,
-		},
+		"tls":      tlsConfig,
 	}
 
 	if isTracingEnabled(dest) {
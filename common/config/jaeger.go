@@ -9,7 +9,6 @@ import (
 var (
 	ErrorJaegerTracingDisabled = errors.New("attempting to configure Jaeger tracing, but tracing is disabled")
 	ErrorJaegerMissingURL      = errors.New("missing Jaeger JAEGER_URL config")
-	ErrorJaegerNoTls           = errors.New("jaeger destination only supports non tls connections")
 )
 
 const (
@@ -33,7 +32,12 @@ func (j *Jaeger) ModifyConfig(dest ExporterConfigurer, currentConfig *Config) er
 		return ErrorJaegerMissingURL
 	}
 
-	grpcEndpoint, err := parseUnencryptedOtlpGrpcUrl(url)
+	grpcEndpoint, schemeTLS, err := parseOtlpGrpcUrl(url)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := getOtlpGrpcTLSConfig(dest, schemeTLS)
 	if err != nil {
 		return err
 	}
@@ -41,9 +45,7 @@ func (j *Jaeger) ModifyConfig(dest ExporterConfigurer, currentConfig *Config) er
 	exporterName := "otlp/jaeger-" + dest.GetID()
 	currentConfig.Exporters[exporterName] = GenericMap{
 		"endpoint": grpcEndpoint,
-		"tls": GenericMap{
-			"insecure": true,
-		},
+		"tls":      tlsConfig,
 	}
 
 	pipelineName := "traces/jaeger-" + dest.GetID()
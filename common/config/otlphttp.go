@@ -53,6 +53,17 @@ func (g *OTLPHttp) ModifyConfig(dest ExporterConfigurer, currentConfig *Config)
 			"authenticator": basicAuthExtensionName,
 		}
 	}
+	if hasTLSOptions(dest) {
+		// tls is used according to the endpoint scheme
+		if !strings.HasPrefix(parsedUrl, "https://") {
+			return errors.New("otlp http tls options require an https endpoint, gateway will not be configured for otlp http")
+		}
+		tlsConfig, err := getTLSConfig(dest)
+		if err != nil {
+			return errors.Join(err, errors.New("otlp http tls options invalid, gateway will not be configured for otlp http"))
+		}
+		exporterConf["tls"] = tlsConfig
+	}
 	currentConfig.Exporters[otlpHttpExporterName] = exporterConf
 
 	if isTracingEnabled(dest) {
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// PersistentQueueDirectory is where the gateway mounts the volume for the persistent queues
+	PersistentQueueDirectory = "/var/odigos/queue"
+
+	persistentQueueStorageName = "file_storage/queue"
+	defaultPersistentQueueSize = 5000
+
+	// the estimated size of a queued batch on disk, the queue volumes are sized by it
+	persistentQueueBatchSizeMiB = 1
+)
+
+// PersistentQueueConfigurer is implemented by exporter configurers that can queue telemetry on the gateway disk
+type PersistentQueueConfigurer interface {
+	GetPersistentQueue() (enabled bool, queueSize int)
+}
+
+// exporters that have no sending_queue, or one that cannot be backed by a storage extension
+var exportersWithoutPersistentQueue = map[string]bool{
+	"awss3":                 true,
+	"debug":                 true,
+	"logging":               true,
+	"prometheusremotewrite": true,
+}
+
+// applyPersistentQueue backs the sending queue of the exporters added for the destination by the file storage extension
+func applyPersistentQueue(dest ExporterConfigurer, exporterNames []string, currentConfig *Config) error {
+	pqConfigurer, ok := dest.(PersistentQueueConfigurer)
+	if !ok {
+		return nil
+	}
+	enabled, queueSize := pqConfigurer.GetPersistentQueue()
+	if !enabled {
+		return nil
+	}
+	if queueSize < 0 {
+		return fmt.Errorf("persistent queue size must not be negative, got %d", queueSize)
+	}
+
+	sort.Strings(exporterNames)
+	for _, exporterName := range exporterNames {
+		exporterType, _, _ := strings.Cut(exporterName, "/")
+		if exportersWithoutPersistentQueue[exporterType] {
+			return fmt.Errorf("persistent queue is not supported by the %s exporter", exporterType)
+		}
+
+		exporterConfig, err := exporterConfigMap(currentConfig, exporterName)
+		if err != nil {
+			return err
+		}
+
+		sendingQueue, ok := exporterConfig["sending_queue"].(GenericMap)
+		if !ok {
+			sendingQueue = GenericMap{}
+		}
+		sendingQueue["enabled"] = true
+		sendingQueue["storage"] = persistentQueueStorageName
+		if queueSize > 0 {
+			sendingQueue["queue_size"] = queueSize
+		} else if _, ok := sendingQueue["queue_size"]; !ok {
+			// keep a queue size set in the advanced options of the destination
+			sendingQueue["queue_size"] = defaultPersistentQueueSize
+		}
+		exporterConfig["sending_queue"] = sendingQueue
+	}
+
+	if _, exists := currentConfig.Extensions[persistentQueueStorageName]; !exists {
+		currentConfig.Extensions[persistentQueueStorageName] = GenericMap{
+			"directory": PersistentQueueDirectory,
+			// reclaim the disk space of batches sent after an outage
+			"compaction": GenericMap{
+				"directory":  PersistentQueueDirectory,
+				"on_start":   true,
+				"on_rebound": true,
+			},
+		}
+		currentConfig.Service.Extensions = append(currentConfig.Service.Extensions, persistentQueueStorageName)
+	}
+
+	return nil
+}
+
+// PersistentQueueSizeMiB returns the disk size the persistent queues of the gateway config take when they are full, 0 if there are none.
+// it is read from the queue sizes of the exporters, so destinations that failed to be added are not counted.
+func PersistentQueueSizeMiB(configData string) (int, error) {
+	var currentConfig Config
+	if err := yaml.Unmarshal([]byte(configData), &currentConfig); err != nil {
+		return 0, err
+	}
+
+	queuedBatches := 0
+	for _, exporterConfig := range currentConfig.Exporters {
+		exporterMap, ok := exporterConfig.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sendingQueue, ok := exporterMap["sending_queue"].(map[string]interface{})
+		if !ok || sendingQueue["storage"] != persistentQueueStorageName {
+			continue
+		}
+		queueSize, err := parseQueueSize(sendingQueue["queue_size"])
+		if err != nil {
+			return 0, err
+		}
+		queuedBatches += queueSize
+	}
+	return queuedBatches * persistentQueueBatchSizeMiB, nil
+}
+
+func parseQueueSize(value interface{}) (int, error) {
+	switch queueSize := value.(type) {
+	case nil:
+		// the exporter default, which the persistent queue never leaves unset
+		return defaultPersistentQueueSize, nil
+	case int:
+		return queueSize, nil
+	case uint64:
+		return int(queueSize), nil
+	case int64:
+		return int(queueSize), nil
+	case float64:
+		return int(queueSize), nil
+	default:
+		return 0, fmt.Errorf("invalid queue size %v", value)
+	}
+}
@@ -8,11 +8,10 @@ func GetCrdProcessorsConfigMap(processors []ProcessorConfigurer) (cfg GenericMap
 	errs = make(map[string]error)
 	cfg = GenericMap{}
 	for _, processor := range processors {
-		processorKey := fmt.Sprintf("%s/%s", processor.GetType(), processor.GetID())
+		processorKey := ProcessorKey(processor)
 		processorsConfig, err := processor.GetConfig()
 		if err != nil {
-			// TODO: write the error to the status of the processor
-			// consider how to handle this error
+			// reported on the status of the processor by the autoscaler
 			errs[processor.GetID()] = fmt.Errorf("failed to convert processor %q to collector config: %w", processor.GetID(), err)
 			continue
 		}
@@ -36,3 +35,8 @@ func GetCrdProcessorsConfigMap(processors []ProcessorConfigurer) (cfg GenericMap
 	}
 	return
 }
+
+// ProcessorKey is the name of the processor in the collector config
+func ProcessorKey(processor ProcessorConfigurer) string {
+	return fmt.Sprintf("%s/%s", processor.GetType(), processor.GetID())
+}
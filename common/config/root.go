@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -30,10 +31,36 @@ type Configer interface {
 type ResourceStatuses struct {
 	Destination map[string]error
 	Processor   map[string]error
+
+	// the destination or processor that added each component to the config, keyed by ComponentKey,
+	// so errors found when validating the config can be reported on them
+	DestinationComponents map[string]string
+	ProcessorComponents   map[string]string
+}
+
+// ComponentKey identifies a component in the collector config by its section and name, e.g. "exporters::otlp/my-destination"
+func ComponentKey(section string, name string) string {
+	return fmt.Sprintf("%s::%s", section, name)
+}
+
+// RecordComponentErrors reports the errors of components on the destinations and processors that added them,
+// and returns the errors of components that were not added by any of them
+func (s *ResourceStatuses) RecordComponentErrors(componentErrs map[string]error) []error {
+	var unowned []error
+	for key, err := range componentErrs {
+		if destID, found := s.DestinationComponents[key]; found {
+			s.Destination[destID] = errors.Join(s.Destination[destID], err)
+		} else if processorID, found := s.ProcessorComponents[key]; found {
+			s.Processor[processorID] = errors.Join(s.Processor[processorID], err)
+		} else {
+			unowned = append(unowned, err)
+		}
+	}
+	return unowned
 }
 
 func Calculate(dests []ExporterConfigurer, processors []ProcessorConfigurer, memoryLimiterConfig GenericMap) (string, error, *ResourceStatuses) {
-	currentConfig, globalProcessors := getBasicConfig(memoryLimiterConfig)
+	currentConfig, globalProcessors := GetBasicConfig(memoryLimiterConfig)
 	return CalculateWithBase(currentConfig, globalProcessors, dests, processors)
 }
 
@@ -44,8 +71,10 @@ func CalculateWithBase(currentConfig *Config, globalProcessors []string, dests [
 	}
 
 	status := &ResourceStatuses{
-		Destination: make(map[string]error),
-		Processor:   make(map[string]error),
+		Destination:           make(map[string]error),
+		Processor:             make(map[string]error),
+		DestinationComponents: make(map[string]string),
+		ProcessorComponents:   make(map[string]string),
 	}
 
 	for _, p := range globalProcessors {
@@ -66,8 +95,36 @@ func CalculateWithBase(currentConfig *Config, globalProcessors []string, dests [
 			continue
 		}
 
+		existingExporters := make(map[string]bool, len(currentConfig.Exporters))
+		for exporterName := range currentConfig.Exporters {
+			existingExporters[exporterName] = true
+		}
+		existingComponents := componentKeys(currentConfig)
+		snapshot := snapshotConfig(currentConfig)
+
 		err := configer.ModifyConfig(dest, currentConfig)
+		if err == nil {
+			var destExporters []string
+			for exporterName := range currentConfig.Exporters {
+				if !existingExporters[exporterName] {
+					destExporters = append(destExporters, exporterName)
+				}
+			}
+			err = applyExporterOptions(dest, destExporters, currentConfig)
+			if err == nil {
+				err = applyPersistentQueue(dest, destExporters, currentConfig)
+			}
+		}
+		if err != nil {
+			// the destination is not rolled out, drop what it added before failing
+			snapshot.restore(currentConfig)
+		}
 		status.Destination[dest.GetID()] = err
+		for key := range componentKeys(currentConfig) {
+			if !existingComponents[key] {
+				status.DestinationComponents[key] = dest.GetID()
+			}
+		}
 
 		// If configurer ran without errors, but there were no signals enabled, warn the user
 		if len(dest.GetSignals()) == 0 && err == nil {
@@ -79,6 +136,9 @@ func CalculateWithBase(currentConfig *Config, globalProcessors []string, dests [
 	if errs != nil {
 		status.Processor = errs
 	}
+	for _, processor := range processors {
+		status.ProcessorComponents[ComponentKey("processors", ProcessorKey(processor))] = processor.GetID()
+	}
 	for processorKey, processorCfg := range processorsCfg {
 		currentConfig.Processors[processorKey] = processorCfg
 	}
@@ -107,7 +167,8 @@ func CalculateWithBase(currentConfig *Config, globalProcessors []string, dests [
 	return string(data), nil, status
 }
 
-func getBasicConfig(memoryLimiterConfig GenericMap) (*Config, []string) {
+// GetBasicConfig returns the gateway config every destination is added to, and the processors common to all pipelines
+func GetBasicConfig(memoryLimiterConfig GenericMap) (*Config, []string) {
 	empty := struct{}{}
 	return &Config{
 		Receivers: GenericMap{
@@ -146,6 +207,124 @@ func getBasicConfig(memoryLimiterConfig GenericMap) (*Config, []string) {
 	}, []string{memoryLimiterProcessorName, "resource/odigos-version"}
 }
 
+// configSnapshot holds the components and service of a config, so the changes made to it by a destination can be rolled back
+type configSnapshot struct {
+	receivers         GenericMap
+	processors        GenericMap
+	exporters         GenericMap
+	connectors        GenericMap
+	extensions        GenericMap
+	serviceExtensions []string
+	pipelines         map[string]Pipeline
+}
+
+// snapshotConfig deep copies the config, as configers may change the components and pipelines added by other destinations
+func snapshotConfig(currentConfig *Config) *configSnapshot {
+	return &configSnapshot{
+		receivers:         copyGenericMap(currentConfig.Receivers),
+		processors:        copyGenericMap(currentConfig.Processors),
+		exporters:         copyGenericMap(currentConfig.Exporters),
+		connectors:        copyGenericMap(currentConfig.Connectors),
+		extensions:        copyGenericMap(currentConfig.Extensions),
+		serviceExtensions: copyStrings(currentConfig.Service.Extensions),
+		pipelines:         copyPipelines(currentConfig.Service.Pipelines),
+	}
+}
+
+func (s *configSnapshot) restore(currentConfig *Config) {
+	currentConfig.Receivers = copyGenericMap(s.receivers)
+	currentConfig.Processors = copyGenericMap(s.processors)
+	currentConfig.Exporters = copyGenericMap(s.exporters)
+	currentConfig.Connectors = copyGenericMap(s.connectors)
+	currentConfig.Extensions = copyGenericMap(s.extensions)
+	currentConfig.Service.Extensions = copyStrings(s.serviceExtensions)
+	currentConfig.Service.Pipelines = copyPipelines(s.pipelines)
+}
+
+// copyGenericMap deep copies the map and the components configs in it
+func copyGenericMap(m GenericMap) GenericMap {
+	if m == nil {
+		return nil
+	}
+	copied := make(GenericMap, len(m))
+	for key, value := range m {
+		copied[key] = copyValue(value)
+	}
+	return copied
+}
+
+// copyValue deep copies the maps and slices a component config is built of, other values are copied as is
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case GenericMap:
+		return copyGenericMap(v)
+	case map[string]interface{}:
+		return map[string]interface{}(copyGenericMap(v))
+	case map[string]string:
+		copied := make(map[string]string, len(v))
+		for key, value := range v {
+			copied[key] = value
+		}
+		return copied
+	case []GenericMap:
+		copied := make([]GenericMap, len(v))
+		for i, item := range v {
+			copied[i] = copyGenericMap(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = copyValue(item)
+		}
+		return copied
+	case []string:
+		return copyStrings(v)
+	default:
+		return value
+	}
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
+
+func copyPipelines(pipelines map[string]Pipeline) map[string]Pipeline {
+	if pipelines == nil {
+		return nil
+	}
+	copied := make(map[string]Pipeline, len(pipelines))
+	for name, pipeline := range pipelines {
+		copied[name] = Pipeline{
+			Receivers:  copyStrings(pipeline.Receivers),
+			Processors: copyStrings(pipeline.Processors),
+			Exporters:  copyStrings(pipeline.Exporters),
+		}
+	}
+	return copied
+}
+
+// componentKeys returns the keys of all the components in the config
+func componentKeys(currentConfig *Config) map[string]bool {
+	keys := map[string]bool{}
+	sections := map[string]GenericMap{
+		"receivers":  currentConfig.Receivers,
+		"processors": currentConfig.Processors,
+		"exporters":  currentConfig.Exporters,
+		"connectors": currentConfig.Connectors,
+		"extensions": currentConfig.Extensions,
+	}
+	for section, components := range sections {
+		for name := range components {
+			keys[ComponentKey(section, name)] = true
+		}
+	}
+	return keys
+}
+
 func loadConfigers() (map[common.DestinationType]Configer, error) {
 	configers := map[common.DestinationType]Configer{}
 	for _, configer := range availableConfigers {
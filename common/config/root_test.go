@@ -1,9 +1,11 @@
 package config_test
 
 import (
+	"errors"
 	"os"
 	"testing"
 
+	"github.com/goccy/go-yaml"
 	"github.com/odigos-io/odigos/common"
 	"github.com/odigos-io/odigos/common/config"
 	"github.com/stretchr/testify/assert"
@@ -152,3 +154,600 @@ func TestCalculateWithBaseNoOTLP(t *testing.T) {
 	assert.Equal(t, len(statuses.Destination), 0)
 	assert.Equal(t, len(statuses.Processor), 0)
 }
+
+type PersistentQueueDestination struct {
+	DummyDestination
+	Type      common.DestinationType
+	Config    map[string]string
+	QueueSize int
+}
+
+func (dest PersistentQueueDestination) GetType() common.DestinationType {
+	return dest.Type
+}
+func (dest PersistentQueueDestination) GetConfig() map[string]string {
+	return dest.Config
+}
+func (dest PersistentQueueDestination) GetPersistentQueue() (bool, int) {
+	return true, dest.QueueSize
+}
+
+func TestCalculatePersistentQueue(t *testing.T) {
+	dest := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.OtlpHttpDestinationType,
+		Config:           map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318"},
+	}
+	cfg, err, statuses := config.Calculate(
+		[]config.ExporterConfigurer{dest},
+		make([]config.ProcessorConfigurer, 0),
+		make(config.GenericMap),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, statuses.Destination["d1"])
+
+	var parsed config.Config
+	assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+	exporter := parsed.Exporters["otlphttp/generic-d1"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"enabled":    true,
+		"queue_size": uint64(5000),
+		"storage":    "file_storage/queue",
+	}, exporter["sending_queue"])
+	assert.Contains(t, parsed.Extensions, "file_storage/queue")
+	assert.Contains(t, parsed.Service.Extensions, "file_storage/queue")
+
+	sizeMiB, err := config.PersistentQueueSizeMiB(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 5000, sizeMiB)
+}
+
+// the storage exporters return failed writes to the exporter helper, so their batches can be kept on disk during an outage
+func TestCalculatePersistentQueueStorageExporter(t *testing.T) {
+	dest := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.GCSDestinationType,
+		Config:           map[string]string{"GCS_BUCKET": "archive"},
+		QueueSize:        100,
+	}
+	cfg, err, statuses := config.Calculate(
+		[]config.ExporterConfigurer{dest},
+		make([]config.ProcessorConfigurer, 0),
+		make(config.GenericMap),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, statuses.Destination["d1"])
+
+	var parsed config.Config
+	assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+	exporter := parsed.Exporters["googlecloudstorage/d1"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"enabled":    true,
+		"queue_size": uint64(100),
+		"storage":    "file_storage/queue",
+	}, exporter["sending_queue"])
+}
+
+func TestPersistentQueueSizeMiB(t *testing.T) {
+	queued := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.OtlpHttpDestinationType,
+		Config:           map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318"},
+		QueueSize:        100,
+	}
+	tuned := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d2"},
+		Type:             common.OtlpHttpDestinationType,
+		Config:           map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318", "EXPORTER_QUEUE_SIZE": "200"},
+	}
+	// a destination that failed to be added is not counted
+	failed := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d3"},
+		Type:             common.DebugDestinationType,
+		QueueSize:        300,
+	}
+	notQueued := TunedDestination{
+		DummyDestination: DummyDestination{ID: "d4"},
+		Type:             common.OtlpHttpDestinationType,
+		Config:           map[string]string{"OTLP_HTTP_ENDPOINT": "http://collector:4318", "EXPORTER_QUEUE_SIZE": "400"},
+	}
+
+	cfg, err, statuses := config.Calculate(
+		[]config.ExporterConfigurer{queued, tuned, failed, notQueued},
+		make([]config.ProcessorConfigurer, 0),
+		make(config.GenericMap),
+	)
+	assert.Nil(t, err)
+	assert.NotNil(t, statuses.Destination["d3"])
+
+	sizeMiB, err := config.PersistentQueueSizeMiB(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 300, sizeMiB)
+
+	cfg, err, _ = config.Calculate([]config.ExporterConfigurer{notQueued}, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+	assert.Nil(t, err)
+	sizeMiB, err = config.PersistentQueueSizeMiB(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sizeMiB)
+}
+
+func TestCalculatePersistentQueueUnsupported(t *testing.T) {
+	dest := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.DebugDestinationType,
+		QueueSize:        100,
+	}
+	_, err, statuses := config.Calculate(
+		[]config.ExporterConfigurer{dest},
+		make([]config.ProcessorConfigurer, 0),
+		make(config.GenericMap),
+	)
+	assert.Nil(t, err)
+	assert.ErrorContains(t, statuses.Destination["d1"], "not supported by the debug exporter")
+}
+
+type TunedDestination struct {
+	DummyDestination
+	Type   common.DestinationType
+	Config map[string]string
+}
+
+func (dest TunedDestination) GetType() common.DestinationType {
+	return dest.Type
+}
+func (dest TunedDestination) GetConfig() map[string]string {
+	return dest.Config
+}
+
+func TestCalculateRollsBackSharedComponents(t *testing.T) {
+	currentConfig, globalProcessors := config.GetBasicConfig(make(config.GenericMap))
+	// the logzio destinations share the attributes/logzio processor
+	currentConfig.Processors["attributes/logzio"] = config.GenericMap{
+		"actions": []config.GenericMap{{"key": "team", "action": "insert", "value": "checkout"}},
+	}
+	currentConfig.Exporters["otlp/shared"] = config.GenericMap{"endpoint": "collector:4317"}
+	currentConfig.Service.Pipelines["logs/shared"] = config.Pipeline{
+		Processors: []string{"attributes/logzio"},
+		Exporters:  []string{"otlp/shared"},
+	}
+
+	// the destination replaces the shared processor before failing on its queue size
+	dest := PersistentQueueDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.LogzioDestinationType,
+		Config:           map[string]string{"LOGZIO_REGION": "us"},
+		QueueSize:        -1,
+	}
+	cfg, err, statuses := config.CalculateWithBase(currentConfig, globalProcessors, []config.ExporterConfigurer{dest}, nil)
+	assert.Nil(t, err)
+	assert.ErrorContains(t, statuses.Destination["d1"], "must not be negative")
+	assert.Empty(t, statuses.DestinationComponents)
+
+	var parsed config.Config
+	assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+	assert.Equal(t, map[string]interface{}{
+		"actions": []interface{}{
+			map[string]interface{}{"key": "team", "action": "insert", "value": "checkout"},
+		},
+	}, parsed.Processors["attributes/logzio"])
+	assert.Equal(t, config.GenericMap{"otlp/shared": map[string]interface{}{"endpoint": "collector:4317"}}, parsed.Exporters)
+	assert.Len(t, parsed.Service.Pipelines, 1)
+	assert.Equal(t, []string{"otlp/shared"}, parsed.Service.Pipelines["logs/shared"].Exporters)
+}
+
+func TestCalculateExporterOptions(t *testing.T) {
+	dest := TunedDestination{
+		DummyDestination: DummyDestination{ID: "d1"},
+		Type:             common.ChronosphereDestinationType,
+		Config: map[string]string{
+			"CHRONOSPHERE_DOMAIN":             "odigos.chronosphere.io",
+			"EXPORTER_QUEUE_SIZE":             "2000",
+			"EXPORTER_QUEUE_CONSUMERS":        "4",
+			"EXPORTER_RETRY_MAX_INTERVAL":     "1m",
+			"EXPORTER_RETRY_MAX_ELAPSED_TIME": "0",
+			"EXPORTER_TIMEOUT":                "30s",
+			"EXPORTER_COMPRESSION":            "zstd",
+		},
+	}
+	cfg, err, statuses := config.Calculate(
+		[]config.ExporterConfigurer{dest},
+		make([]config.ProcessorConfigurer, 0),
+		make(config.GenericMap),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, statuses.Destination["d1"])
+
+	var parsed config.Config
+	assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+	exporter := parsed.Exporters["otlp/chronosphere-d1"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{
+		"enabled":       true,
+		"queue_size":    uint64(2000),
+		"num_consumers": uint64(4),
+	}, exporter["sending_queue"])
+	assert.Equal(t, map[string]interface{}{
+		"enabled":          true,
+		"max_interval":     "1m0s",
+		"max_elapsed_time": "0s",
+	}, exporter["retry_on_failure"])
+	assert.Equal(t, "30s", exporter["timeout"])
+	assert.Equal(t, "zstd", exporter["compression"])
+}
+
+func TestCalculateExporterOptionsInvalid(t *testing.T) {
+	for name, options := range map[string]map[string]string{
+		"queue size":  {"EXPORTER_QUEUE_SIZE": "-1"},
+		"timeout":     {"EXPORTER_TIMEOUT": "soon"},
+		"compression": {"EXPORTER_COMPRESSION": "brotli"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			options["OTLP_HTTP_ENDPOINT"] = "http://collector:4318"
+			dest := TunedDestination{
+				DummyDestination: DummyDestination{ID: "d1"},
+				Type:             common.OtlpHttpDestinationType,
+				Config:           options,
+			}
+			_, err, statuses := config.Calculate(
+				[]config.ExporterConfigurer{dest},
+				make([]config.ProcessorConfigurer, 0),
+				make(config.GenericMap),
+			)
+			assert.Nil(t, err)
+			assert.Error(t, statuses.Destination["d1"])
+		})
+	}
+}
+
+func TestCalculateExporterOptionsUnsupported(t *testing.T) {
+	allSignals := []common.ObservabilitySignal{common.TracesObservabilitySignal, common.MetricsObservabilitySignal, common.LogsObservabilitySignal}
+	tests := []struct {
+		name        string
+		destType    common.DestinationType
+		config      map[string]string
+		expectedErr string
+	}{
+		{
+			name:        "queue on s3",
+			destType:    common.AWSS3DestinationType,
+			config:      map[string]string{"S3_BUCKET": "archive", "S3_REGION": "us-east-1", "EXPORTER_QUEUE_SIZE": "100"},
+			expectedErr: "EXPORTER_QUEUE_SIZE is not supported by the awss3 exporter",
+		},
+		{
+			name:        "compression on gcs",
+			destType:    common.GCSDestinationType,
+			config:      map[string]string{"EXPORTER_COMPRESSION": "gzip"},
+			expectedErr: "EXPORTER_COMPRESSION is not supported by the googlecloudstorage exporter",
+		},
+		{
+			name:        "retry on elasticsearch",
+			destType:    common.ElasticsearchDestinationType,
+			config:      map[string]string{"ELASTICSEARCH_URL": "http://elasticsearch:9200", "EXPORTER_RETRY_MAX_INTERVAL": "1m"},
+			expectedErr: "EXPORTER_RETRY_MAX_INTERVAL is not supported by the elasticsearch exporter",
+		},
+		{
+			name:        "queue on prometheus",
+			destType:    common.PrometheusDestinationType,
+			config:      map[string]string{"PROMETHEUS_REMOTEWRITE_URL": "http://prometheus:9090/api/v1/write", "EXPORTER_QUEUE_CONSUMERS": "2"},
+			expectedErr: "EXPORTER_QUEUE_CONSUMERS is not supported by the prometheusremotewrite exporter",
+		},
+		{
+			name:     "compression on datadog",
+			destType: common.DatadogDestinationType,
+			config:   map[string]string{"DATADOG_SITE": "datadoghq.com", "EXPORTER_COMPRESSION": "gzip"},
+		},
+		{
+			name:     "timeout on prometheus",
+			destType: common.PrometheusDestinationType,
+			config:   map[string]string{"PROMETHEUS_REMOTEWRITE_URL": "http://prometheus:9090/api/v1/write", "EXPORTER_TIMEOUT": "10s"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dests := []config.ExporterConfigurer{
+				SignalsDestination{
+					TunedDestination: TunedDestination{
+						DummyDestination: DummyDestination{ID: "d1"},
+						Type:             tt.destType,
+						Config:           tt.config,
+					},
+					Signals: allSignals,
+				},
+				TunedDestination{
+					DummyDestination: DummyDestination{ID: "d2"},
+					Type:             common.GenericOTLPDestinationType,
+					Config:           map[string]string{"OTLP_GRPC_ENDPOINT": "collector.internal:4317"},
+				},
+			}
+			cfg, err, statuses := config.Calculate(dests, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+			assert.Nil(t, err)
+			assert.Nil(t, statuses.Destination["d2"])
+			assert.Contains(t, cfg, "otlp/generic-d2")
+			if tt.expectedErr == "" {
+				assert.Nil(t, statuses.Destination["d1"])
+				return
+			}
+
+			assert.EqualError(t, statuses.Destination["d1"], tt.expectedErr)
+			// the exporters, connectors and pipelines added by the destination are dropped with it
+			assert.NotContains(t, cfg, "d1")
+		})
+	}
+}
+
+func TestExporterOptionSupported(t *testing.T) {
+	tests := []struct {
+		destType  common.DestinationType
+		key       string
+		supported bool
+	}{
+		{destType: common.GenericOTLPDestinationType, key: "EXPORTER_COMPRESSION", supported: true},
+		{destType: common.HoneycombDestinationType, key: "EXPORTER_QUEUE_SIZE", supported: true},
+		{destType: common.LokiDestinationType, key: "EXPORTER_COMPRESSION", supported: true},
+		{destType: common.AWSS3DestinationType, key: "EXPORTER_TIMEOUT", supported: false},
+		{destType: common.GCSDestinationType, key: "EXPORTER_COMPRESSION", supported: false},
+		{destType: common.GoogleCloudDestinationType, key: "EXPORTER_RETRY_INITIAL_INTERVAL", supported: false},
+		{destType: common.GoogleCloudDestinationType, key: "EXPORTER_TIMEOUT", supported: true},
+		// opsverse adds otlp, loki and prometheusremotewrite exporters, the queue is not supported by all of them
+		{destType: common.OpsVerseDestinationType, key: "EXPORTER_QUEUE_SIZE", supported: false},
+		{destType: common.OpsVerseDestinationType, key: "EXPORTER_RETRY_MAX_INTERVAL", supported: true},
+		{destType: common.PrometheusDestinationType, key: "EXPORTER_COMPRESSION", supported: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.destType)+" "+tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.supported, config.ExporterOptionSupported(tt.destType, tt.key))
+		})
+	}
+}
+
+func TestCalculateTLS(t *testing.T) {
+	dests := []config.ExporterConfigurer{
+		TunedDestination{
+			DummyDestination: DummyDestination{ID: "d1"},
+			Type:             common.GenericOTLPDestinationType,
+			Config: map[string]string{
+				"OTLP_GRPC_ENDPOINT":       "collector.internal:4317",
+				"TLS_SECRET_NAME":          "collector-certs",
+				"TLS_CLIENT_AUTH_ENABLED":  "true",
+				"TLS_SERVER_NAME_OVERRIDE": "collector",
+			},
+		},
+		// jaeger requires traces
+		TracesDestination{TunedDestination{
+			DummyDestination: DummyDestination{ID: "d2"},
+			Type:             common.JaegerDestinationType,
+			Config:           map[string]string{"JAEGER_URL": "jaeger:4317"},
+		}},
+		TunedDestination{
+			DummyDestination: DummyDestination{ID: "d3"},
+			Type:             common.OtlpHttpDestinationType,
+			Config: map[string]string{
+				"OTLP_HTTP_ENDPOINT":       "http://collector:4318",
+				"TLS_INSECURE_SKIP_VERIFY": "true",
+			},
+		},
+	}
+	cfg, err, statuses := config.Calculate(dests, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+	assert.Nil(t, err)
+	assert.Nil(t, statuses.Destination["d1"])
+	assert.Nil(t, statuses.Destination["d2"])
+	assert.ErrorContains(t, statuses.Destination["d3"], "https endpoint")
+	assert.Equal(t, []string{"collector-certs"}, config.TLSSecretNames(dests))
+
+	var parsed config.Config
+	assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+	assert.Equal(t, map[string]interface{}{
+		"insecure":             false,
+		"ca_file":              "/etc/odigos/tls/collector-certs/ca.crt",
+		"cert_file":            "/etc/odigos/tls/collector-certs/tls.crt",
+		"key_file":             "/etc/odigos/tls/collector-certs/tls.key",
+		"server_name_override": "collector",
+	}, parsed.Exporters["otlp/generic-d1"].(map[string]interface{})["tls"])
+	assert.Equal(t, map[string]interface{}{
+		"insecure": true,
+	}, parsed.Exporters["otlp/jaeger-d2"].(map[string]interface{})["tls"])
+}
+
+type TLSSecretDestination struct {
+	TunedDestination
+	SecretKeys map[string]bool
+}
+
+func (dest TLSSecretDestination) GetTLSSecretKeys() map[string]bool {
+	return dest.SecretKeys
+}
+
+func TestCalculateTLSSecretKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		clientAuth  string
+		secretKeys  map[string]bool
+		expectedErr string
+		expectedTLS map[string]interface{}
+	}{
+		{
+			name:       "secret without a ca",
+			clientAuth: "true",
+			secretKeys: map[string]bool{"tls.crt": true, "tls.key": true},
+			expectedTLS: map[string]interface{}{
+				"insecure":  false,
+				"cert_file": "/etc/odigos/tls/collector-certs/tls.crt",
+				"key_file":  "/etc/odigos/tls/collector-certs/tls.key",
+			},
+		},
+		{
+			name:       "secret with a ca only",
+			secretKeys: map[string]bool{"ca.crt": true},
+			expectedTLS: map[string]interface{}{
+				"insecure": false,
+				"ca_file":  "/etc/odigos/tls/collector-certs/ca.crt",
+			},
+		},
+		{
+			name:        "client auth without a key",
+			clientAuth:  "true",
+			secretKeys:  map[string]bool{"ca.crt": true, "tls.crt": true},
+			expectedErr: "TLS_CLIENT_AUTH_ENABLED requires tls.crt and tls.key in the secret collector-certs",
+		},
+		{
+			name:        "missing secret",
+			expectedErr: "the secret collector-certs set in TLS_SECRET_NAME does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := TLSSecretDestination{
+				TunedDestination: TunedDestination{
+					DummyDestination: DummyDestination{ID: "d1"},
+					Type:             common.GenericOTLPDestinationType,
+					Config: map[string]string{
+						"OTLP_GRPC_ENDPOINT":      "collector.internal:4317",
+						"TLS_SECRET_NAME":         "collector-certs",
+						"TLS_CLIENT_AUTH_ENABLED": tt.clientAuth,
+					},
+				},
+				SecretKeys: tt.secretKeys,
+			}
+			cfg, err, statuses := config.Calculate([]config.ExporterConfigurer{dest}, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+			assert.Nil(t, err)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, statuses.Destination["d1"], tt.expectedErr)
+				assert.NotContains(t, cfg, "d1")
+				return
+			}
+			assert.Nil(t, statuses.Destination["d1"])
+
+			var parsed config.Config
+			assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+			assert.Equal(t, tt.expectedTLS, parsed.Exporters["otlp/generic-d1"].(map[string]interface{})["tls"])
+		})
+	}
+}
+
+func TestCalculateTLSClientAuthWithoutSecret(t *testing.T) {
+	tests := []struct {
+		clientAuth  string
+		expectedErr string
+	}{
+		{clientAuth: "false"},
+		{clientAuth: "true", expectedErr: "TLS_CLIENT_AUTH_ENABLED requires the client certificate and key in the secret set in TLS_SECRET_NAME"},
+		{clientAuth: "yes", expectedErr: `TLS_CLIENT_AUTH_ENABLED must be true or false, got "yes"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.clientAuth, func(t *testing.T) {
+			dest := TunedDestination{
+				DummyDestination: DummyDestination{ID: "d1"},
+				Type:             common.GenericOTLPDestinationType,
+				Config: map[string]string{
+					"OTLP_GRPC_ENDPOINT":      "collector.internal:4317",
+					"TLS_CLIENT_AUTH_ENABLED": tt.clientAuth,
+				},
+			}
+			_, err, statuses := config.Calculate([]config.ExporterConfigurer{dest}, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+			assert.Nil(t, err)
+			if tt.expectedErr == "" {
+				assert.Nil(t, statuses.Destination["d1"])
+				return
+			}
+			assert.ErrorContains(t, statuses.Destination["d1"], tt.expectedErr)
+		})
+	}
+}
+
+type TracesDestination struct {
+	TunedDestination
+}
+
+func (dest TracesDestination) GetSignals() []common.ObservabilitySignal {
+	return []common.ObservabilitySignal{common.TracesObservabilitySignal}
+}
+
+func TestCalculateComponentOwners(t *testing.T) {
+	dests := []config.ExporterConfigurer{
+		TunedDestination{
+			DummyDestination: DummyDestination{ID: "d1"},
+			Type:             common.GenericOTLPDestinationType,
+			Config:           map[string]string{"OTLP_GRPC_ENDPOINT": "collector.internal:4317"},
+		},
+	}
+	_, err, statuses := config.Calculate(dests, make([]config.ProcessorConfigurer, 0), make(config.GenericMap))
+	assert.Nil(t, err)
+	assert.Equal(t, "d1", statuses.DestinationComponents[config.ComponentKey("exporters", "otlp/generic-d1")])
+}
+
+func TestRecordComponentErrors(t *testing.T) {
+	tests := []struct {
+		name                string
+		componentErrs       map[string]error
+		expectedDestination map[string]string
+		expectedProcessor   map[string]string
+		expectedUnowned     int
+	}{
+		{
+			name: "invalid exporter of a destination",
+			componentErrs: map[string]error{
+				config.ComponentKey("exporters", "otlp/generic-d1"): errors.New("invalid endpoint"),
+			},
+			expectedDestination: map[string]string{"d1": "invalid endpoint"},
+		},
+		{
+			name: "connector of a destination",
+			componentErrs: map[string]error{
+				config.ComponentKey("connectors", "forward/d1"): errors.New("invalid connector"),
+			},
+			expectedDestination: map[string]string{"d1": "invalid connector"},
+		},
+		{
+			name: "several errors of the same destination",
+			componentErrs: map[string]error{
+				config.ComponentKey("exporters", "otlp/generic-d1"): errors.New("invalid endpoint"),
+				config.ComponentKey("connectors", "forward/d1"):     errors.New("invalid connector"),
+			},
+			expectedDestination: map[string]string{"d1": "invalid endpoint"},
+		},
+		{
+			name: "invalid processor",
+			componentErrs: map[string]error{
+				config.ComponentKey("processors", "filter/p1"): errors.New("invalid condition"),
+			},
+			expectedProcessor: map[string]string{"p1": "invalid condition"},
+		},
+		{
+			name: "component not added by any resource",
+			componentErrs: map[string]error{
+				config.ComponentKey("receivers", "otlp"): errors.New("invalid receiver"),
+			},
+			expectedUnowned: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := &config.ResourceStatuses{
+				Destination: map[string]error{},
+				Processor:   map[string]error{},
+				DestinationComponents: map[string]string{
+					config.ComponentKey("exporters", "otlp/generic-d1"): "d1",
+					config.ComponentKey("connectors", "forward/d1"):     "d1",
+				},
+				ProcessorComponents: map[string]string{
+					config.ComponentKey("processors", "filter/p1"): "p1",
+				},
+			}
+
+			unowned := statuses.RecordComponentErrors(tt.componentErrs)
+			assert.Len(t, unowned, tt.expectedUnowned)
+			assert.Len(t, statuses.Destination, len(tt.expectedDestination))
+			for id, expectedErr := range tt.expectedDestination {
+				assert.ErrorContains(t, statuses.Destination[id], expectedErr)
+			}
+			assert.Len(t, statuses.Processor, len(tt.expectedProcessor))
+			for id, expectedErr := range tt.expectedProcessor {
+				assert.ErrorContains(t, statuses.Processor[id], expectedErr)
+			}
+		})
+	}
+}
@@ -3,22 +3,112 @@ package config
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/odigos-io/odigos/common"
 )
 
 const (
-	s3BucketKey    = "S3_BUCKET"
-	s3RegionKey    = "S3_REGION"
-	s3PartitionKey = "S3_PARTITION"
-	s3Marshaller   = "S3_MARSHALER"
+	s3BucketKey      = "S3_BUCKET"
+	s3RegionKey      = "S3_REGION"
+	s3PartitionKey   = "S3_PARTITION"
+	s3Marshaller     = "S3_MARSHALER"
+	s3KeyTemplateKey = "S3_KEY_TEMPLATE"
 )
 
 var (
 	ErrS3BucketNotSpecified = fmt.Errorf("s3 bucket not specified")
 	ErrS3RegionNotSpecified = fmt.Errorf("s3 region not specified")
+
+	s3KeyPlaceholder = regexp.MustCompile(`{{\s*([^{}]*?)\s*}}`)
+
+	// s3TimeSegments is the layout the awss3 exporter writes objects under, minute is only written by the minute partition
+	s3TimeSegments = []string{"year={{yyyy}}", "month={{MM}}", "day={{dd}}", "hour={{HH}}", "minute={{mm}}"}
 )
 
+const s3TimeLayout = "year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}[/minute={{mm}}]"
+
+// s3KeyTemplate is a key template mapped onto the awss3 exporter, which writes objects under
+// <s3_prefix>/year=yyyy/month=MM/day=dd/hour=HH[/minute=mm]. Only templates the exporter renders as written are
+// accepted: a prefix of literals and {{signal}}, followed by that time layout.
+type s3KeyTemplate struct {
+	prefix    string
+	partition string
+	bySignal  bool
+}
+
+func parseS3KeyTemplate(template string) (*s3KeyTemplate, error) {
+	kt := &s3KeyTemplate{}
+	// the exporter writes <s3_prefix>/<time>/, so the template needs a prefix and a single / between its parts
+	if !strings.HasSuffix(template, "/") {
+		return nil, fmt.Errorf("the S3 key template must end with /: %s", template)
+	}
+	var prefix []string
+	timeSegments := 0
+	for _, segment := range strings.Split(strings.TrimSuffix(template, "/"), "/") {
+		if segment == "" {
+			return nil, fmt.Errorf("the S3 key template may not hold empty parts: %s", template)
+		}
+		if rest := s3KeyPlaceholder.ReplaceAllString(segment, ""); strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+			return nil, fmt.Errorf("unclosed placeholder in S3 key template: %s", segment)
+		}
+
+		normalized := s3KeyPlaceholder.ReplaceAllString(segment, "{{$1}}")
+		if timeSegments < len(s3TimeSegments) && normalized == s3TimeSegments[timeSegments] {
+			timeSegments++
+			continue
+		}
+
+		hasSignal := false
+		for _, match := range s3KeyPlaceholder.FindAllStringSubmatch(segment, -1) {
+			switch name := match[1]; {
+			case name == "signal":
+				hasSignal = true
+			case name == "namespace" || name == "service" || strings.HasPrefix(name, "resource."):
+				return nil, fmt.Errorf("the {{%s}} placeholder is not supported in S3 key templates, the S3 exporter cannot split batches by resource", name)
+			case isS3TimeFormat(name):
+				return nil, fmt.Errorf("the {{%s}} placeholder in %s is not supported in S3 key templates, the S3 exporter only writes the time as %s", name, segment, s3TimeLayout)
+			default:
+				return nil, fmt.Errorf("unknown placeholder in S3 key template: {{%s}}", name)
+			}
+		}
+		if timeSegments > 0 {
+			return nil, fmt.Errorf("the S3 key template must end with %s, found %s after it", s3TimeLayout, segment)
+		}
+		kt.bySignal = kt.bySignal || hasSignal
+		prefix = append(prefix, segment)
+	}
+
+	switch timeSegments {
+	case len(s3TimeSegments):
+		kt.partition = "minute"
+	case len(s3TimeSegments) - 1:
+		kt.partition = "hour"
+	default:
+		return nil, fmt.Errorf("the S3 key template must end with %s, the layout the S3 exporter writes objects under", s3TimeLayout)
+	}
+	if len(prefix) == 0 {
+		return nil, fmt.Errorf("the S3 key template must start with a prefix before %s", s3TimeLayout)
+	}
+	kt.prefix = strings.Join(prefix, "/")
+	return kt, nil
+}
+
+// isS3TimeFormat reports whether the placeholder is a time format such as yyyy-MM-dd
+func isS3TimeFormat(name string) bool {
+	format := name
+	for _, token := range []string{"yyyy", "MM", "dd", "HH", "mm"} {
+		format = strings.ReplaceAll(format, token, "")
+	}
+	return format != name && strings.Trim(format, "-_.") == ""
+}
+
+// renderPrefix returns the s3_prefix of the exporter of the signal
+func (kt *s3KeyTemplate) renderPrefix(signal string) string {
+	return s3KeyPlaceholder.ReplaceAllString(kt.prefix, signal)
+}
+
 type AWSS3 struct{}
 
 func (s *AWSS3) DestType() common.DestinationType {
@@ -57,35 +147,49 @@ func (s *AWSS3) ModifyConfig(dest ExporterConfigurer, currentConfig *Config) err
 		return errors.New("Invalid marshaller specified, gateway will not be configured for AWS S3")
 	}
 
-	exporterName := "awss3/" + dest.GetID()
-	currentConfig.Exporters[exporterName] = GenericMap{
-		"s3uploader": GenericMap{
+	keyTemplate := &s3KeyTemplate{}
+	if template, ok := dest.GetConfig()[s3KeyTemplateKey]; ok && template != "" {
+		var err error
+		keyTemplate, err = parseS3KeyTemplate(template)
+		if err != nil {
+			return err
+		}
+		partition = keyTemplate.partition
+	}
+
+	// the awss3 exporter has a single prefix, so an exporter is added per signal when the prefix holds the signal
+	addSignal := func(signal string) {
+		exporterName := "awss3/" + dest.GetID()
+		if keyTemplate.bySignal {
+			exporterName += "-" + signal
+		}
+		s3uploader := GenericMap{
 			"region":       region,
 			"s3_bucket":    bucket,
 			"s3_partition": partition,
-		},
-		"marshaler": marshaler,
+		}
+		if prefix := keyTemplate.renderPrefix(signal); prefix != "" {
+			s3uploader["s3_prefix"] = prefix
+		}
+		currentConfig.Exporters[exporterName] = GenericMap{
+			"s3uploader": s3uploader,
+			"marshaler":  marshaler,
+		}
+		currentConfig.Service.Pipelines[signal+"/awss3-"+dest.GetID()] = Pipeline{
+			Exporters: []string{exporterName},
+		}
 	}
 
 	if isLoggingEnabled(dest) {
-		logsPipelineName := "logs/awss3-" + dest.GetID()
-		currentConfig.Service.Pipelines[logsPipelineName] = Pipeline{
-			Exporters: []string{exporterName},
-		}
+		addSignal("logs")
 	}
 
 	if isMetricsEnabled(dest) {
-		metricsPipelineName := "metrics/awss3-" + dest.GetID()
-		currentConfig.Service.Pipelines[metricsPipelineName] = Pipeline{
-			Exporters: []string{exporterName},
-		}
+		addSignal("metrics")
 	}
 
 	if isTracingEnabled(dest) {
-		tracesPipelineName := "traces/awss3-" + dest.GetID()
-		currentConfig.Service.Pipelines[tracesPipelineName] = Pipeline{
-			Exporters: []string{exporterName},
-		}
+		addSignal("traces")
 	}
 
 	return nil
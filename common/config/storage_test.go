@@ -0,0 +1,377 @@
+package config_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SignalsDestination struct {
+	TunedDestination
+	Signals []common.ObservabilitySignal
+}
+
+func (dest SignalsDestination) GetSignals() []common.ObservabilitySignal {
+	return dest.Signals
+}
+
+func TestStorageDestinationsParquetSignals(t *testing.T) {
+	tests := []struct {
+		name           string
+		destType       common.DestinationType
+		config         map[string]string
+		signals        []common.ObservabilitySignal
+		expectedErr    string
+		expectedExport string
+	}{
+		{
+			name:           "gcs parquet traces and logs",
+			destType:       common.GCSDestinationType,
+			config:         map[string]string{"GCS_MARSHALER": "parquet"},
+			signals:        []common.ObservabilitySignal{common.TracesObservabilitySignal, common.LogsObservabilitySignal},
+			expectedExport: "googlecloudstorage/d1",
+		},
+		{
+			name:        "gcs parquet metrics",
+			destType:    common.GCSDestinationType,
+			config:      map[string]string{"GCS_MARSHALER": "parquet"},
+			signals:     []common.ObservabilitySignal{common.TracesObservabilitySignal, common.MetricsObservabilitySignal},
+			expectedErr: "The parquet marshaller does not support metrics",
+		},
+		{
+			name:           "gcs json metrics",
+			destType:       common.GCSDestinationType,
+			config:         map[string]string{"GCS_MARSHALER": "otlp_json"},
+			signals:        []common.ObservabilitySignal{common.MetricsObservabilitySignal},
+			expectedExport: "googlecloudstorage/d1",
+		},
+		{
+			name:           "azure blob parquet logs",
+			destType:       common.AzureBlobDestinationType,
+			config:         map[string]string{"AZURE_BLOB_ACCOUNT_NAME": "account", "AZURE_BLOB_CONTAINER_NAME": "container", "AZURE_BLOB_MARSHALER": "parquet"},
+			signals:        []common.ObservabilitySignal{common.LogsObservabilitySignal},
+			expectedExport: "azureblobstorage/d1",
+		},
+		{
+			name:        "azure blob parquet metrics",
+			destType:    common.AzureBlobDestinationType,
+			config:      map[string]string{"AZURE_BLOB_ACCOUNT_NAME": "account", "AZURE_BLOB_CONTAINER_NAME": "container", "AZURE_BLOB_MARSHALER": "parquet"},
+			signals:     []common.ObservabilitySignal{common.MetricsObservabilitySignal},
+			expectedErr: "The parquet marshaller does not support metrics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := SignalsDestination{
+				TunedDestination: TunedDestination{
+					DummyDestination: DummyDestination{ID: "d1"},
+					Type:             tt.destType,
+					Config:           tt.config,
+				},
+				Signals: tt.signals,
+			}
+			cfg, err, statuses := config.Calculate(
+				[]config.ExporterConfigurer{dest},
+				make([]config.ProcessorConfigurer, 0),
+				make(config.GenericMap),
+			)
+			assert.Nil(t, err)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, statuses.Destination["d1"], tt.expectedErr)
+				assert.NotContains(t, cfg, "d1")
+				return
+			}
+			assert.Nil(t, statuses.Destination["d1"])
+			assert.Contains(t, cfg, tt.expectedExport)
+		})
+	}
+}
+
+func TestStorageDestinationsObjectBatching(t *testing.T) {
+	tests := []struct {
+		name        string
+		destType    common.DestinationType
+		config      map[string]string
+		expectedErr string
+		// expected is the uploader config of the exporter
+		expected map[string]interface{}
+	}{
+		{
+			name:     "gcs batching and compression",
+			destType: common.GCSDestinationType,
+			config:   map[string]string{"GCS_BUCKET": "archive", "GCS_MAX_OBJECT_BYTES": "8388608", "GCS_MAX_OBJECT_AGE": "1m", "GCS_COMPRESSION": "zstd"},
+			expected: map[string]interface{}{"bucket": "archive", "max_object_bytes": uint64(8388608), "max_object_age": "1m0s", "compression": "zstd"},
+		},
+		{
+			name:     "gcs without batching",
+			destType: common.GCSDestinationType,
+			config:   map[string]string{"GCS_BUCKET": "archive", "GCS_COMPRESSION": "none"},
+			expected: map[string]interface{}{"bucket": "archive"},
+		},
+		{
+			name:     "azure blob batching and compression",
+			destType: common.AzureBlobDestinationType,
+			config:   map[string]string{"AZURE_BLOB_ACCOUNT_NAME": "account", "AZURE_BLOB_CONTAINER_NAME": "container", "AZURE_BLOB_MAX_OBJECT_AGE": "30s", "AZURE_BLOB_COMPRESSION": "gzip"},
+			expected: map[string]interface{}{"account_name": "account", "container": "container", "max_object_age": "30s", "compression": "gzip"},
+		},
+		{
+			name:        "invalid compression",
+			destType:    common.GCSDestinationType,
+			config:      map[string]string{"GCS_COMPRESSION": "snappy"},
+			expectedErr: "GCS_COMPRESSION must be one of none, gzip or zstd",
+		},
+		{
+			name:        "invalid max age",
+			destType:    common.AzureBlobDestinationType,
+			config:      map[string]string{"AZURE_BLOB_ACCOUNT_NAME": "account", "AZURE_BLOB_CONTAINER_NAME": "container", "AZURE_BLOB_MAX_OBJECT_AGE": "soon"},
+			expectedErr: "AZURE_BLOB_MAX_OBJECT_AGE must be a positive duration",
+		},
+		{
+			name:        "invalid max size",
+			destType:    common.GCSDestinationType,
+			config:      map[string]string{"GCS_MAX_OBJECT_BYTES": "-1"},
+			expectedErr: "GCS_MAX_OBJECT_BYTES must be a positive integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := SignalsDestination{
+				TunedDestination: TunedDestination{
+					DummyDestination: DummyDestination{ID: "d1"},
+					Type:             tt.destType,
+					Config:           tt.config,
+				},
+				Signals: []common.ObservabilitySignal{common.TracesObservabilitySignal},
+			}
+			cfg, err, statuses := config.Calculate(
+				[]config.ExporterConfigurer{dest},
+				make([]config.ProcessorConfigurer, 0),
+				make(config.GenericMap),
+			)
+			assert.Nil(t, err)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, statuses.Destination["d1"], tt.expectedErr)
+				return
+			}
+			assert.Nil(t, statuses.Destination["d1"])
+
+			var parsed config.Config
+			require.NoError(t, yaml.Unmarshal([]byte(cfg), &parsed))
+			require.Len(t, parsed.Exporters, 1)
+			for _, exporter := range parsed.Exporters {
+				exporterConfig := exporter.(map[string]interface{})
+				uploader, ok := exporterConfig["gcs"]
+				if !ok {
+					uploader = exporterConfig["blob"]
+				}
+				assert.Equal(t, tt.expected, uploader)
+			}
+		})
+	}
+}
+
+func TestS3KeyTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		partition   string
+		expectedErr string
+		// expected is the s3uploader of each exporter, by exporter name
+		expected map[string]map[string]interface{}
+	}{
+		{
+			name:      "no template",
+			partition: "hour",
+			expected: map[string]map[string]interface{}{
+				"awss3/d1": {"region": "us-east-1", "s3_bucket": "archive", "s3_partition": "hour"},
+			},
+		},
+		{
+			name:     "static prefix and hour partition",
+			template: "otel/archive/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/",
+			expected: map[string]map[string]interface{}{
+				"awss3/d1": {"region": "us-east-1", "s3_bucket": "archive", "s3_partition": "hour", "s3_prefix": "otel/archive"},
+			},
+		},
+		{
+			name:      "template overrides the configured partition",
+			template:  "otel/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/minute={{mm}}/",
+			partition: "hour",
+			expected: map[string]map[string]interface{}{
+				"awss3/d1": {"region": "us-east-1", "s3_bucket": "archive", "s3_partition": "minute", "s3_prefix": "otel"},
+			},
+		},
+		{
+			name:     "signal prefix and minute partition",
+			template: "{{signal}}/archive/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/minute={{mm}}/",
+			expected: map[string]map[string]interface{}{
+				"awss3/d1-traces": {"region": "us-east-1", "s3_bucket": "archive", "s3_partition": "minute", "s3_prefix": "traces/archive"},
+				"awss3/d1-logs":   {"region": "us-east-1", "s3_bucket": "archive", "s3_partition": "minute", "s3_prefix": "logs/archive"},
+			},
+		},
+		{
+			name:        "namespace",
+			template:    "{{signal}}/{{namespace}}/{{service}}/dt={{yyyy-MM-dd}}/hour={{HH}}/",
+			expectedErr: "the {{namespace}} placeholder is not supported in S3 key templates",
+		},
+		{
+			name:        "resource attribute",
+			template:    "{{signal}}/{{resource.k8s.deployment.name}}/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/",
+			expectedErr: "the {{resource.k8s.deployment.name}} placeholder is not supported in S3 key templates",
+		},
+		{
+			name:        "time format",
+			template:    "archive/dt={{yyyy-MM-dd}}/hour={{HH}}/",
+			expectedErr: "the {{yyyy-MM-dd}} placeholder in dt={{yyyy-MM-dd}} is not supported in S3 key templates",
+		},
+		{
+			name:        "time layout without a name",
+			template:    "archive/{{yyyy}}/{{MM}}/{{dd}}/{{HH}}/",
+			expectedErr: "the {{yyyy}} placeholder in {{yyyy}} is not supported in S3 key templates",
+		},
+		{
+			name:        "partial time layout",
+			template:    "archive/year={{yyyy}}/month={{MM}}/",
+			expectedErr: "must end with year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}[/minute={{mm}}]",
+		},
+		{
+			name:        "no time layout",
+			template:    "otel/",
+			expectedErr: "must end with year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}[/minute={{mm}}]",
+		},
+		{
+			name:        "literal after the time layout",
+			template:    "otel/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/archive/",
+			expectedErr: "found archive after it",
+		},
+		{
+			name:        "no prefix",
+			template:    "year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/",
+			expectedErr: "must start with a prefix",
+		},
+		{
+			name:        "no trailing slash",
+			template:    "otel/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}",
+			expectedErr: "must end with /",
+		},
+		{
+			name:        "empty part",
+			template:    "otel//year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/",
+			expectedErr: "may not hold empty parts",
+		},
+		{
+			name:        "unknown placeholder",
+			template:    "{{team}}/year={{yyyy}}/month={{MM}}/day={{dd}}/hour={{HH}}/",
+			expectedErr: "unknown placeholder in S3 key template: {{team}}",
+		},
+		{
+			name:        "unclosed placeholder",
+			template:    "{{signal/",
+			expectedErr: "unclosed placeholder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destConfig := map[string]string{"S3_BUCKET": "archive", "S3_REGION": "us-east-1"}
+			if tt.template != "" {
+				destConfig["S3_KEY_TEMPLATE"] = tt.template
+			}
+			if tt.partition != "" {
+				destConfig["S3_PARTITION"] = tt.partition
+			}
+			dest := SignalsDestination{
+				TunedDestination: TunedDestination{
+					DummyDestination: DummyDestination{ID: "d1"},
+					Type:             common.AWSS3DestinationType,
+					Config:           destConfig,
+				},
+				Signals: []common.ObservabilitySignal{common.TracesObservabilitySignal, common.LogsObservabilitySignal},
+			}
+			cfg, err, statuses := config.Calculate(
+				[]config.ExporterConfigurer{dest},
+				make([]config.ProcessorConfigurer, 0),
+				make(config.GenericMap),
+			)
+			assert.Nil(t, err)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, statuses.Destination["d1"], tt.expectedErr)
+				assert.NotContains(t, cfg, "awss3")
+				return
+			}
+			assert.Nil(t, statuses.Destination["d1"])
+
+			var parsed config.Config
+			assert.Nil(t, yaml.Unmarshal([]byte(cfg), &parsed))
+			uploaders := map[string]map[string]interface{}{}
+			for name, exporter := range parsed.Exporters {
+				uploaders[name] = exporter.(map[string]interface{})["s3uploader"].(map[string]interface{})
+			}
+			assert.Equal(t, tt.expected, uploaders)
+			for name := range tt.expected {
+				assert.Contains(t, cfg, "- "+name+"\n")
+			}
+		})
+	}
+}
+
+// TestS3KeyTemplateMatchesStorageKeys checks the S3 exporter writes the keys the Google Cloud Storage and Azure Blob
+// Storage exporters render for the same templates, which are tested against the same file in their modules
+func TestS3KeyTemplateMatchesStorageKeys(t *testing.T) {
+	data, err := os.ReadFile("testdata/storage_key_templates.json")
+	require.NoError(t, err)
+	var cases []struct {
+		Template string    `json:"template"`
+		Signal   string    `json:"signal"`
+		Time     time.Time `json:"time"`
+		Key      string    `json:"key"`
+	}
+	require.NoError(t, json.Unmarshal(data, &cases))
+
+	for _, tc := range cases {
+		dest := SignalsDestination{
+			TunedDestination: TunedDestination{
+				DummyDestination: DummyDestination{ID: "d1"},
+				Type:             common.AWSS3DestinationType,
+				Config:           map[string]string{"S3_BUCKET": "archive", "S3_REGION": "us-east-1", "S3_KEY_TEMPLATE": tc.Template},
+			},
+			Signals: []common.ObservabilitySignal{common.ObservabilitySignal(strings.ToUpper(tc.Signal))},
+		}
+		cfg, err, statuses := config.Calculate(
+			[]config.ExporterConfigurer{dest},
+			make([]config.ProcessorConfigurer, 0),
+			make(config.GenericMap),
+		)
+		require.NoError(t, err)
+		require.NoError(t, statuses.Destination["d1"], tc.Template)
+
+		var parsed config.Config
+		require.NoError(t, yaml.Unmarshal([]byte(cfg), &parsed))
+		require.Len(t, parsed.Exporters, 1)
+		for _, exporter := range parsed.Exporters {
+			uploader := exporter.(map[string]interface{})["s3uploader"].(map[string]interface{})
+			prefix, _ := uploader["s3_prefix"].(string)
+			assert.Equal(t, tc.Key, s3Key(prefix, uploader["s3_partition"].(string), tc.Time), tc.Template)
+		}
+	}
+}
+
+// s3Key is the key the awss3 exporter writes objects under, up to the file name
+func s3Key(prefix string, partition string, t time.Time) string {
+	timeKey := fmt.Sprintf("year=%d/month=%02d/day=%02d/hour=%02d", t.Year(), t.Month(), t.Day(), t.Hour())
+	if partition != "hour" {
+		timeKey += fmt.Sprintf("/minute=%02d", t.Minute())
+	}
+	return prefix + "/" + timeKey + "/"
+}
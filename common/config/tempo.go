@@ -2,8 +2,6 @@ package config
 
 import (
 	"errors"
-	"fmt"
-	"strings"
 
 	"github.com/odigos-io/odigos/common"
 )
@@ -25,20 +23,21 @@ func (t *Tempo) ModifyConfig(dest ExporterConfigurer, currentConfig *Config) err
 		return errors.New("Tempo url not specified, gateway will not be configured for Tempo")
 	}
 
-	if strings.HasPrefix(url, "https://") {
-		return errors.New("Tempo does not currently supports tls export, gateway will not be configured for Tempo")
-	}
-
 	if isTracingEnabled(dest) {
-		url = strings.TrimPrefix(url, "http://")
-		url = strings.TrimSuffix(url, ":4317")
+		grpcEndpoint, schemeTLS, err := parseOtlpGrpcUrl(url)
+		if err != nil {
+			return errors.Join(err, errors.New("Tempo url invalid, gateway will not be configured for Tempo"))
+		}
+
+		tlsConfig, err := getOtlpGrpcTLSConfig(dest, schemeTLS)
+		if err != nil {
+			return errors.Join(err, errors.New("Tempo tls options invalid, gateway will not be configured for Tempo"))
+		}
 
 		tempoExporterName := "otlp/tempo-" + dest.GetID()
 		currentConfig.Exporters[tempoExporterName] = GenericMap{
-			"endpoint": fmt.Sprintf("%s:4317", url),
-			"tls": GenericMap{
-				"insecure": true,
-			},
+			"endpoint": grpcEndpoint,
+			"tls":      tlsConfig,
 		}
 
 		tracesPipelineName := "traces/tempo-" + dest.GetID()
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	// TLSSecretsDirectory is where the gateway mounts the secrets referenced by destinations for tls,
+	// each secret in a directory named after it
+	TLSSecretsDirectory = "/etc/odigos/tls"
+
+	// keys expected in the secret, as in secrets of type kubernetes.io/tls
+	TLSSecretCAKey   = "ca.crt"
+	TLSSecretCertKey = "tls.crt"
+	TLSSecretKeyKey  = "tls.key"
+)
+
+// tls options of the otlp based destinations
+const (
+	tlsSecretNameKey         = "TLS_SECRET_NAME"
+	tlsClientAuthEnabledKey  = "TLS_CLIENT_AUTH_ENABLED"
+	tlsInsecureSkipVerifyKey = "TLS_INSECURE_SKIP_VERIFY"
+	tlsServerNameOverrideKey = "TLS_SERVER_NAME_OVERRIDE"
+)
+
+// destination types whose configers render the tls options
+var destinationsWithTLS = map[string]bool{
+	"otlp":     true,
+	"otlphttp": true,
+	"jaeger":   true,
+	"tempo":    true,
+}
+
+// TLSSecretConfigurer is implemented by exporter configurers that know the keys of the tls secret they reference,
+// so a missing secret or certificate fails the destination instead of the gateway.
+// when it is not implemented, the secret is assumed to hold the keys the tls options need
+type TLSSecretConfigurer interface {
+	// GetTLSSecretKeys returns the keys of the tls secret, or nil if the secret does not exist
+	GetTLSSecretKeys() map[string]bool
+}
+
+// hasTLSOptions returns true if any of the tls options is set on the destination
+func hasTLSOptions(dest ExporterConfigurer) bool {
+	config := dest.GetConfig()
+	for _, key := range []string{tlsSecretNameKey, tlsClientAuthEnabledKey, tlsInsecureSkipVerifyKey, tlsServerNameOverrideKey} {
+		if value := strings.TrimSpace(config[key]); value != "" && value != "false" {
+			return true
+		}
+	}
+	return false
+}
+
+// getOtlpGrpcTLSConfig returns the tls block of an otlp grpc exporter, which is insecure
+// unless the endpoint scheme requires tls or any of the tls options is set
+func getOtlpGrpcTLSConfig(dest ExporterConfigurer, schemeTLS bool) (GenericMap, error) {
+	if !schemeTLS && !hasTLSOptions(dest) {
+		return GenericMap{
+			"insecure": true,
+		}, nil
+	}
+	return getTLSConfig(dest)
+}
+
+// getTLSConfig returns the tls block of the exporter, with the certificates read from the secret mounted on the gateway
+func getTLSConfig(dest ExporterConfigurer) (GenericMap, error) {
+	config := dest.GetConfig()
+	tlsConfig := GenericMap{
+		"insecure": false,
+	}
+
+	clientAuth, err := parseBool(config, tlsClientAuthEnabledKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secretName := strings.TrimSpace(config[tlsSecretNameKey])
+	if secretName != "" {
+		secretKeys := map[string]bool{TLSSecretCAKey: true, TLSSecretCertKey: true, TLSSecretKeyKey: true}
+		if secretConfigurer, ok := dest.(TLSSecretConfigurer); ok {
+			secretKeys = secretConfigurer.GetTLSSecretKeys()
+			if secretKeys == nil {
+				return nil, fmt.Errorf("the secret %s set in %s does not exist", secretName, tlsSecretNameKey)
+			}
+		}
+
+		secretDir := path.Join(TLSSecretsDirectory, secretName)
+		// without a ca the endpoint is verified with the system roots
+		if secretKeys[TLSSecretCAKey] {
+			tlsConfig["ca_file"] = path.Join(secretDir, TLSSecretCAKey)
+		}
+
+		if clientAuth {
+			if !secretKeys[TLSSecretCertKey] || !secretKeys[TLSSecretKeyKey] {
+				return nil, fmt.Errorf("%s requires %s and %s in the secret %s", tlsClientAuthEnabledKey, TLSSecretCertKey, TLSSecretKeyKey, secretName)
+			}
+			tlsConfig["cert_file"] = path.Join(secretDir, TLSSecretCertKey)
+			tlsConfig["key_file"] = path.Join(secretDir, TLSSecretKeyKey)
+		}
+	} else if clientAuth {
+		return nil, fmt.Errorf("%s requires the client certificate and key in the secret set in %s", tlsClientAuthEnabledKey, tlsSecretNameKey)
+	}
+
+	insecureSkipVerify, err := parseBool(config, tlsInsecureSkipVerifyKey)
+	if err != nil {
+		return nil, err
+	}
+	if insecureSkipVerify {
+		tlsConfig["insecure_skip_verify"] = true
+	}
+
+	if serverName := strings.TrimSpace(config[tlsServerNameOverrideKey]); serverName != "" {
+		tlsConfig["server_name_override"] = serverName
+	}
+
+	return tlsConfig, nil
+}
+
+func parseBool(config map[string]string, key string) (bool, error) {
+	switch strings.TrimSpace(config[key]) {
+	case "", "false":
+		return false, nil
+	case "true":
+		return true, nil
+	default:
+		return false, fmt.Errorf("%s must be true or false, got %q", key, config[key])
+	}
+}
+
+// TLSSecretNames returns the sorted names of the secrets the gateway should mount for the tls of the destinations
+func TLSSecretNames(dests []ExporterConfigurer) []string {
+	secrets := map[string]bool{}
+	for _, dest := range dests {
+		if !destinationsWithTLS[string(dest.GetType())] {
+			continue
+		}
+		if secretName := strings.TrimSpace(dest.GetConfig()[tlsSecretNameKey]); secretName != "" {
+			secrets[secretName] = true
+		}
+	}
+
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -8,6 +8,18 @@ import (
 )
 
 func parseUnencryptedOtlpGrpcUrl(rawURL string) (grpcUrl string, err error) {
+	grpcUrl, tls, err := parseOtlpGrpcUrl(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if tls {
+		return "", fmt.Errorf("grpc endpoint does not support tls")
+	}
+	return grpcUrl, nil
+}
+
+// parseOtlpGrpcUrl returns the host:port of the grpc endpoint, and whether its scheme (https or grpcs) requires tls
+func parseOtlpGrpcUrl(rawURL string) (grpcUrl string, tls bool, err error) {
 
 	rawURL = strings.TrimSpace(rawURL)
 	urlWithScheme := rawURL
@@ -20,30 +32,27 @@ func parseUnencryptedOtlpGrpcUrl(rawURL string) (grpcUrl string, err error) {
 
 	parsedUrl, err := url.Parse(urlWithScheme)
 	if err != nil {
-		return "", err
-	}
-
-	if parsedUrl.Scheme == "https" || parsedUrl.Scheme == "grpcs" {
-		return "", fmt.Errorf("grpc endpoint does not support tls")
+		return "", false, err
 	}
 
-	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "grpc" {
-		return "", fmt.Errorf("unexpected scheme %s", parsedUrl.Scheme)
+	tls = parsedUrl.Scheme == "https" || parsedUrl.Scheme == "grpcs"
+	if !tls && parsedUrl.Scheme != "http" && parsedUrl.Scheme != "grpc" {
+		return "", false, fmt.Errorf("unexpected scheme %s", parsedUrl.Scheme)
 	}
 
 	// validate no path is provided, as this indicates using improper url (like otlp http with /v1/traces path)
 	if parsedUrl.Path != "" {
-		return "", fmt.Errorf("unexpected path for grpc endpoint %s", parsedUrl.Path)
+		return "", false, fmt.Errorf("unexpected path for grpc endpoint %s", parsedUrl.Path)
 	}
 
 	// validate no query is provided, as this indicates using improper endpoint
 	if parsedUrl.RawQuery != "" {
-		return "", fmt.Errorf("unexpected query for grpc endpoint %s", parsedUrl.RawQuery)
+		return "", false, fmt.Errorf("unexpected query for grpc endpoint %s", parsedUrl.RawQuery)
 	}
 
 	// in grpc endpoint, there is no user or password
 	if parsedUrl.User != nil {
-		return "", fmt.Errorf("unexpected user info for grpc endpoint %s", parsedUrl.User)
+		return "", false, fmt.Errorf("unexpected user info for grpc endpoint %s", parsedUrl.User)
 	}
 
 	// we default to port 4317 for otlp grpc.
@@ -55,11 +64,11 @@ func parseUnencryptedOtlpGrpcUrl(rawURL string) (grpcUrl string, err error) {
 
 	host, port, err := net.SplitHostPort(hostport)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 
 	if host == "" {
-		return "", fmt.Errorf("missing host in grpc endpoint %s", rawURL)
+		return "", false, fmt.Errorf("missing host in grpc endpoint %s", rawURL)
 	}
 
 	// Check if the host is an IPv6 address and enclose it in square brackets
@@ -67,7 +76,7 @@ func parseUnencryptedOtlpGrpcUrl(rawURL string) (grpcUrl string, err error) {
 		host = "[" + host + "]"
 	}
 
-	return fmt.Sprintf("%s:%s", host, port), nil
+	return fmt.Sprintf("%s:%s", host, port), tls, nil
 }
 
 func urlHostContainsPort(host string) bool {
@@ -78,3 +87,34 @@ func urlHostContainsPort(host string) bool {
 		return strings.Contains(host, ":")
 	}
 }
+
+// applyObjectBatching sets the batching and compression of a storage exporter from the destination config keys
+// starting with the given prefix, such as GCS_MAX_OBJECT_BYTES
+func applyObjectBatching(config map[string]string, keyPrefix string, uploaderConfig GenericMap) error {
+	maxBytesKey, maxAgeKey, compressionKey := keyPrefix+"MAX_OBJECT_BYTES", keyPrefix+"MAX_OBJECT_AGE", keyPrefix+"COMPRESSION"
+
+	maxBytes, err := parsePositiveInt(config, maxBytesKey)
+	if err != nil {
+		return err
+	}
+	if maxBytes > 0 {
+		uploaderConfig["max_object_bytes"] = maxBytes
+	}
+
+	maxAge, err := parseDuration(config, maxAgeKey, false)
+	if err != nil {
+		return err
+	}
+	if maxAge != "" {
+		uploaderConfig["max_object_age"] = maxAge
+	}
+
+	switch compression := strings.TrimSpace(config[compressionKey]); compression {
+	case "", "none":
+	case "gzip", "zstd":
+		uploaderConfig["compression"] = compression
+	default:
+		return fmt.Errorf("%s must be one of none, gzip or zstd, got %q", compressionKey, compression)
+	}
+	return nil
+}
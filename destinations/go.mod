@@ -8,10 +8,16 @@ require (
 )
 
 require (
+	github.com/fatih/color v1.10.0 // indirect
 	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/goccy/go-yaml v1.11.3 // indirect
 	github.com/kr/text v0.2.0 // indirect
+	github.com/mattn/go-colorable v0.1.8 // indirect
+	github.com/mattn/go-isatty v0.0.12 // indirect
 	go.opentelemetry.io/otel v1.24.0 // indirect
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
 )
 
 replace github.com/odigos-io/odigos/common => ../common
@@ -3,10 +3,11 @@ package destinations
 import (
 	"embed"
 
+	"github.com/odigos-io/odigos/common/config"
 	"gopkg.in/yaml.v3"
 )
 
-//go:embed data/*
+//go:embed data/* advanced.yaml
 var destsFS embed.FS
 
 // array of all destinations configs
@@ -31,6 +32,21 @@ func load(fs embed.FS) error {
 	var dests []Destination
 	var destsByTypeMap = make(map[string]Destination)
 
+	// advanced fields tune the exporters of the destination, each destination gets the ones its exporters support
+	var advanced struct {
+		Fields []Field `yaml:"fields"`
+	}
+	advancedData, err := fs.ReadFile("advanced.yaml")
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(advancedData, &advanced); err != nil {
+		return err
+	}
+	for i := range advanced.Fields {
+		advanced.Fields[i].Advanced = true
+	}
+
 	// load all files in the data directory
 	files, err := fs.ReadDir("data")
 	if err != nil {
@@ -50,6 +66,12 @@ func load(fs embed.FS) error {
 			return err
 		}
 
+		for _, field := range advanced.Fields {
+			if config.ExporterOptionSupported(dest.Metadata.Type, field.Name) {
+				dest.Spec.Fields = append(dest.Spec.Fields, field)
+			}
+		}
+
 		destsByTypeMap[string(dest.Metadata.Type)] = dest
 		dests = append(dests, dest)
 	}
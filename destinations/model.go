@@ -40,4 +40,6 @@ type Field struct {
 	ComponentProps map[string]interface{} `yaml:"componentProps"`
 	Secret         bool                   `yaml:"secret"`
 	InitialValue   string                 `yaml:"initialValue"`
+	// Advanced fields are optional tuning shown apart from the destination fields
+	Advanced bool `yaml:"-"`
 }
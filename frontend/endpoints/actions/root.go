@@ -12,6 +12,47 @@ type IcaInstanceResponse struct {
 	Spec interface{} `json:"spec"`
 }
 
+// RegisterRoutes serves the actions api of the ui under the given group
+func RegisterRoutes(apis *gin.RouterGroup, odigosns string) {
+	apis.GET("/actions", func(c *gin.Context) { GetActions(c, odigosns) })
+
+	// AddClusterInfo
+	apis.GET("/actions/types/AddClusterInfo/:id", func(c *gin.Context) { GetAddClusterInfo(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/AddClusterInfo", func(c *gin.Context) { CreateAddClusterInfo(c, odigosns) })
+	apis.PUT("/actions/types/AddClusterInfo/:id", func(c *gin.Context) { UpdateAddClusterInfo(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/AddClusterInfo/:id", func(c *gin.Context) { DeleteAddClusterInfo(c, odigosns, c.Param("id")) })
+
+	// DeleteAttribute
+	apis.GET("/actions/types/DeleteAttribute/:id", func(c *gin.Context) { GetDeleteAttribute(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/DeleteAttribute", func(c *gin.Context) { CreateDeleteAttribute(c, odigosns) })
+	apis.PUT("/actions/types/DeleteAttribute/:id", func(c *gin.Context) { UpdateDeleteAttribute(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/DeleteAttribute/:id", func(c *gin.Context) { DeleteDeleteAttribute(c, odigosns, c.Param("id")) })
+
+	// RenameAttribute
+	apis.GET("/actions/types/RenameAttribute/:id", func(c *gin.Context) { GetRenameAttribute(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/RenameAttribute", func(c *gin.Context) { CreateRenameAttribute(c, odigosns) })
+	apis.PUT("/actions/types/RenameAttribute/:id", func(c *gin.Context) { UpdateRenameAttribute(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/RenameAttribute/:id", func(c *gin.Context) { DeleteRenameAttribute(c, odigosns, c.Param("id")) })
+
+	// ProbabilisticSampler
+	apis.GET("/actions/types/ProbabilisticSampler/:id", func(c *gin.Context) { GetProbabilisticSampler(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/ProbabilisticSampler", func(c *gin.Context) { CreateProbabilisticSampler(c, odigosns) })
+	apis.PUT("/actions/types/ProbabilisticSampler/:id", func(c *gin.Context) { UpdateProbabilisticSampler(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/ProbabilisticSampler/:id", func(c *gin.Context) { DeleteProbabilisticSampler(c, odigosns, c.Param("id")) })
+
+	// ErrorSampler
+	apis.GET("/actions/types/ErrorSampler/:id", func(c *gin.Context) { GetErrorSampler(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/ErrorSampler", func(c *gin.Context) { CreateErrorSampler(c, odigosns) })
+	apis.PUT("/actions/types/ErrorSampler/:id", func(c *gin.Context) { UpdateErrorSampler(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/ErrorSampler/:id", func(c *gin.Context) { DeleteErrorSampler(c, odigosns, c.Param("id")) })
+
+	// LatencySampler
+	apis.GET("/actions/types/LatencySampler/:id", func(c *gin.Context) { GetLatencySampler(c, odigosns, c.Param("id")) })
+	apis.POST("/actions/types/LatencySampler", func(c *gin.Context) { CreateLatencySampler(c, odigosns) })
+	apis.PUT("/actions/types/LatencySampler/:id", func(c *gin.Context) { UpdateLatencySampler(c, odigosns, c.Param("id")) })
+	apis.DELETE("/actions/types/LatencySampler/:id", func(c *gin.Context) { DeleteLatencySampler(c, odigosns, c.Param("id")) })
+}
+
 func GetActions(c *gin.Context, odigosns string) {
 
 	response := []IcaInstanceResponse{}
@@ -64,5 +105,53 @@ func GetActions(c *gin.Context, odigosns string) {
 		})
 	}
 
+	psActions, err := kube.DefaultClient.ActionsClient.ProbabilisticSamplers(odigosns).List(c, metav1.ListOptions{})
+	if err != nil {
+		c.JSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, action := range psActions.Items {
+		response = append(response, IcaInstanceResponse{
+			Id:   action.Name,
+			Type: action.Kind,
+			Spec: action.Spec,
+		})
+	}
+
+	esActions, err := kube.DefaultClient.ActionsClient.ErrorSamplers(odigosns).List(c, metav1.ListOptions{})
+	if err != nil {
+		c.JSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, action := range esActions.Items {
+		response = append(response, IcaInstanceResponse{
+			Id:   action.Name,
+			Type: action.Kind,
+			Spec: action.Spec,
+		})
+	}
+
+	lsActions, err := kube.DefaultClient.ActionsClient.LatencySamplers(odigosns).List(c, metav1.ListOptions{})
+	if err != nil {
+		c.JSON(500, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	for _, action := range lsActions.Items {
+		response = append(response, IcaInstanceResponse{
+			Id:   action.Name,
+			Type: action.Kind,
+			Spec: action.Spec,
+		})
+	}
+
 	c.JSON(200, response)
 }
@@ -0,0 +1,179 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/odigos-io/odigos/api/actions/v1alpha1"
+	"github.com/odigos-io/odigos/api/generated/actions/clientset/versioned/fake"
+	"github.com/odigos-io/odigos/frontend/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testNamespace = "odigos-system"
+
+// useFakeActionsClient makes the handlers use a fake actions client, which names created actions from their generate name
+func useFakeActionsClient(t *testing.T) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	// the fake tracker does not generate names
+	clientset.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		obj := action.(k8stesting.CreateAction).GetObject().(metav1.Object)
+		if obj.GetName() == "" {
+			obj.SetName(obj.GetGenerateName() + "test")
+		}
+		return false, nil, nil
+	})
+
+	previous := kube.DefaultClient
+	kube.SetDefaultClient(&kube.Client{ActionsClient: clientset.ActionsV1alpha1()})
+	t.Cleanup(func() { kube.SetDefaultClient(previous) })
+	return clientset
+}
+
+// newSamplerRouter serves the routes the ui server registers for the actions
+func newSamplerRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	RegisterRoutes(r.Group("/api"), testNamespace)
+	return r
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	routes := map[string]bool{}
+	for _, route := range newSamplerRouter().Routes() {
+		routes[route.Method+" "+route.Path] = true
+	}
+
+	assert.True(t, routes["GET /api/actions"])
+	for _, actionType := range []string{"AddClusterInfo", "DeleteAttribute", "RenameAttribute", "ProbabilisticSampler", "ErrorSampler", "LatencySampler"} {
+		path := "/api/actions/types/" + actionType
+		assert.True(t, routes["POST "+path], actionType)
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+			assert.True(t, routes[method+" "+path+"/:id"], "%s %s", method, actionType)
+		}
+	}
+	assert.Len(t, routes, 25)
+}
+
+func doRequest(r *gin.Engine, method string, path string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func createdID(t *testing.T, w *httptest.ResponseRecorder) string {
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	var response struct {
+		Id string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return response.Id
+}
+
+func TestErrorSamplerRoutes(t *testing.T) {
+	clientset := useFakeActionsClient(t)
+	r := newSamplerRouter()
+
+	w := doRequest(r, http.MethodPost, "/api/actions/types/ErrorSampler",
+		`{"signals":["TRACES"],"fallback_sampling_ratio":10,"service_name":"frontend","http_status_codes":["5xx"]}`)
+	id := createdID(t, w)
+	assert.Equal(t, "es-test", id)
+
+	w = doRequest(r, http.MethodGet, "/api/actions/types/ErrorSampler/"+id, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var spec v1alpha1.ErrorSamplerSpec
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	assert.Equal(t, 10.0, spec.FallbackSamplingRatio)
+	assert.Equal(t, "frontend", spec.ServiceName)
+	assert.Equal(t, []string{"5xx"}, spec.HttpStatusCodes)
+
+	w = doRequest(r, http.MethodPut, "/api/actions/types/ErrorSampler/"+id, `{"signals":["TRACES"],"fallback_sampling_ratio":50}`)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	updated, err := clientset.ActionsV1alpha1().ErrorSamplers(testNamespace).Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	// the spec is replaced, fields missing from the request are cleared
+	assert.Equal(t, 50.0, updated.Spec.FallbackSamplingRatio)
+	assert.Empty(t, updated.Spec.ServiceName)
+	assert.Empty(t, updated.Spec.HttpStatusCodes)
+
+	w = doRequest(r, http.MethodGet, "/api/actions", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var actions []IcaInstanceResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &actions))
+	require.Len(t, actions, 1)
+	assert.Equal(t, id, actions[0].Id)
+
+	w = doRequest(r, http.MethodDelete, "/api/actions/types/ErrorSampler/"+id, "")
+	require.Equal(t, http.StatusNoContent, w.Code)
+	w = doRequest(r, http.MethodGet, "/api/actions/types/ErrorSampler/"+id, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = doRequest(r, http.MethodDelete, "/api/actions/types/ErrorSampler/"+id, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = doRequest(r, http.MethodPut, "/api/actions/types/ErrorSampler/"+id, `{"signals":["TRACES"]}`)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestLatencySamplerRoutes(t *testing.T) {
+	clientset := useFakeActionsClient(t)
+	r := newSamplerRouter()
+
+	w := doRequest(r, http.MethodPost, "/api/actions/types/LatencySampler", `{"signals":["TRACES"],"endpoints_filters":[
+		{"http_route":"/api/**","match_type":"glob","service_name":"frontend","minimum_latency_threshold":1000,"fallback_sampling_ratio":20}]}`)
+	id := createdID(t, w)
+	assert.Equal(t, "ls-test", id)
+
+	w = doRequest(r, http.MethodGet, "/api/actions/types/LatencySampler/"+id, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var spec v1alpha1.LatencySamplerSpec
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	require.Len(t, spec.EndpointsFilters, 1)
+	assert.Equal(t, v1alpha1.HttpRouteFilter{
+		HttpRoute:               "/api/**",
+		MatchType:               "glob",
+		ServiceName:             "frontend",
+		MinimumLatencyThreshold: 1000,
+		FallbackSamplingRatio:   20,
+	}, spec.EndpointsFilters[0])
+
+	w = doRequest(r, http.MethodPut, "/api/actions/types/LatencySampler/"+id, `{"signals":["TRACES"],"endpoints_filters":[
+		{"http_route":"/buy","service_name":"frontend","minimum_latency_threshold":500,"fallback_sampling_ratio":0}]}`)
+	require.Equal(t, http.StatusNoContent, w.Code)
+	updated, err := clientset.ActionsV1alpha1().LatencySamplers(testNamespace).Get(context.Background(), id, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, updated.Spec.EndpointsFilters, 1)
+	assert.Equal(t, "/buy", updated.Spec.EndpointsFilters[0].HttpRoute)
+	assert.Equal(t, 500, updated.Spec.EndpointsFilters[0].MinimumLatencyThreshold)
+
+	w = doRequest(r, http.MethodPost, "/api/actions/types/LatencySampler", `{"endpoints_filters":"not a list"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = doRequest(r, http.MethodDelete, "/api/actions/types/LatencySampler/"+id, "")
+	require.Equal(t, http.StatusNoContent, w.Code)
+	w = doRequest(r, http.MethodGet, "/api/actions/types/LatencySampler/"+id, "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestProbabilisticSamplerRoutes(t *testing.T) {
+	useFakeActionsClient(t)
+	r := newSamplerRouter()
+
+	w := doRequest(r, http.MethodPost, "/api/actions/types/ProbabilisticSampler", `{"signals":["TRACES"],"sampling_percentage":"10"}`)
+	id := createdID(t, w)
+
+	w = doRequest(r, http.MethodGet, "/api/actions/types/ProbabilisticSampler/"+id, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var spec v1alpha1.ProbabilisticSamplerSpec
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	assert.Equal(t, "10", spec.SamplingPercentage)
+}
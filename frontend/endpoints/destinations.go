@@ -86,6 +86,7 @@ type Field struct {
 	VideoUrl            string                 `json:"video_url,omitempty"`
 	ThumbnailURL        string                 `json:"thumbnail_url,omitempty"`
 	InitialValue        string                 `json:"initial_value,omitempty"`
+	Advanced            bool                   `json:"advanced,omitempty"`
 }
 
 func GetDestinationTypeDetails(c *gin.Context) {
@@ -108,6 +109,7 @@ func GetDestinationTypeDetails(c *gin.Context) {
 			VideoUrl:            field.VideoURL,
 			ThumbnailURL:        field.ThumbnailURL,
 			InitialValue:        field.InitialValue,
+			Advanced:            field.Advanced,
 		})
 	}
 
@@ -17,6 +17,12 @@ export const FieldWrapper = styled.div`
   width: 348px;
 `;
 
+export const AdvancedFieldsToggle = styled.div`
+  margin-top: 32px;
+  cursor: pointer;
+  width: fit-content;
+`;
+
 export const CreateDestinationButtonWrapper = styled.div`
   margin-top: 48px;
   height: 36px;
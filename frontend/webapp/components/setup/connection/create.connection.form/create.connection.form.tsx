@@ -20,6 +20,7 @@ import {
   CheckboxWrapper,
   ConnectionMonitorsWrapper,
   FieldWrapper,
+  AdvancedFieldsToggle,
   CreateDestinationButtonWrapper,
 } from './create.connection.form.styled';
 
@@ -73,6 +74,7 @@ export function CreateConnectionForm({
 }: CreateConnectionFormProps) {
   const [selectedMonitors, setSelectedMonitors] = useState(MONITORS);
   const [isCreateButtonDisabled, setIsCreateButtonDisabled] = useState(true);
+  const [showAdvancedFields, setShowAdvancedFields] = useState(false);
   const [dynamicFields, setDynamicFields] = useState(
     sanitizeDynamicFields(fields, dynamicFieldsValues)
   );
@@ -215,7 +217,26 @@ export function CreateConnectionForm({
           required
         />
       </FieldWrapper>
-      {renderFields(fields, dynamicFields, handleDynamicFieldChange)}
+      {renderFields(
+        fields?.filter((field) => !field.advanced),
+        dynamicFields,
+        handleDynamicFieldChange
+      )}
+      {fields?.some((field) => field.advanced) && (
+        <AdvancedFieldsToggle
+          onClick={() => setShowAdvancedFields(!showAdvancedFields)}
+        >
+          <KeyvalText size={14} weight={600}>
+            {`${showAdvancedFields ? '-' : '+'} ${SETUP.ADVANCED_SETTINGS}`}
+          </KeyvalText>
+        </AdvancedFieldsToggle>
+      )}
+      {showAdvancedFields &&
+        renderFields(
+          fields?.filter((field) => field.advanced),
+          dynamicFields,
+          handleDynamicFieldChange
+        )}
       <CreateDestinationButtonWrapper>
         <KeyvalButton disabled={isCreateButtonDisabled} onClick={onCreateClick}>
           <KeyvalText color={theme.colors.dark_blue} size={14} weight={600}>
@@ -65,6 +65,7 @@ export interface Field {
   component_properties: any;
   video_url: string;
   initial_value?: string;
+  advanced?: boolean;
 }
 
 export interface DestinationConfig {
@@ -38,6 +38,7 @@ export const SETUP = {
   NONE_SOURCE_SELECTED: 'No source selected',
   MANAGED: 'Managed',
   CREATE_CONNECTION: 'Create Connection',
+  ADVANCED_SETTINGS: 'Advanced Settings',
   UPDATE_CONNECTION: 'Update Connection',
   CONNECTION_MONITORS: 'This connection will monitor:',
   MONITORS: {